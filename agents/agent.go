@@ -0,0 +1,168 @@
+package agents
+
+import (
+	"fmt"
+	"strings"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// Agent processes events published on the event bus of a session.
+type Agent interface {
+	// ID returns the identifier of the agent in the form agent:<name>.
+	ID() string
+	// Register subscribes the agent to the events it handles.
+	Register(s *core.Session) error
+}
+
+// SessionStartHandler is implemented by agents that need to run when the
+// session starts, before any target is published.
+type SessionStartHandler interface {
+	OnSessionStart()
+}
+
+// SessionEndHandler is implemented by agents that need to release resources
+// once all targets are processed.
+type SessionEndHandler interface {
+	OnSessionEnd()
+}
+
+// SessionAbortHandler is implemented by agents that start processes or create
+// files which must not outlive an aborted scan. OnSessionAbort may be called
+// while tasks are still running and must return quickly.
+type SessionAbortHandler interface {
+	OnSessionAbort()
+}
+
+type agentFactory struct {
+	name    string
+	factory func() Agent
+}
+
+var registry []agentFactory
+
+func init() {
+	RegisterAgent("tcp_port_scanner", func() Agent { return NewTCPPortScanner() })
+	RegisterAgent("url_publisher", func() Agent { return NewURLPublisher() })
+	RegisterAgent("url_requester", func() Agent { return NewURLRequester() })
+	RegisterAgent("url_path_prober", func() Agent { return NewURLPathProber() })
+	RegisterAgent("url_vhost_enumerator", func() Agent { return NewURLVHostEnumerator() })
+	RegisterAgent("url_hostname_resolver", func() Agent { return NewURLHostnameResolver() })
+	RegisterAgent("url_page_title_extractor", func() Agent { return NewURLPageTitleExtractor() })
+	RegisterAgent("url_screenshotter", func() Agent { return NewURLScreenshotter() })
+	RegisterAgent("url_technology_fingerprinter", func() Agent { return NewURLTechnologyFingerprinter() })
+	RegisterAgent("url_takeover_detector", func() Agent { return NewURLTakeoverDetector() })
+	RegisterAgent("url_certificate_analyzer", func() Agent { return NewURLCertificateAnalyzer() })
+	RegisterAgent("url_jsonl_writer", func() Agent { return NewURLJSONLWriter() })
+}
+
+// RegisterAgent makes an agent available under name, which is what
+// -agents and -disable-agents refer to. Agents are attached to a session in
+// the order they were registered. It panics if name is already taken.
+func RegisterAgent(name string, factory func() Agent) {
+	for _, f := range registry {
+		if f.name == name {
+			panic(fmt.Sprintf("agent %s is already registered", name))
+		}
+	}
+	registry = append(registry, agentFactory{name: name, factory: factory})
+}
+
+// AgentNames returns the names of all registered agents.
+func AgentNames() []string {
+	names := make([]string, len(registry))
+	for i, f := range registry {
+		names[i] = f.name
+	}
+	return names
+}
+
+// EnabledAgents returns new instances of the registered agents selected with
+// -agents and -disable-agents, followed by the external agents given with
+// -external-agents.
+func EnabledAgents(o core.Options) ([]Agent, error) {
+	enabled, err := agentSet(*o.Agents)
+	if err != nil {
+		return nil, err
+	}
+	disabled, err := agentSet(*o.DisableAgents)
+	if err != nil {
+		return nil, err
+	}
+
+	var agents []Agent
+	for _, f := range registry {
+		if _, ok := disabled[f.name]; ok {
+			continue
+		}
+		if _, ok := enabled[f.name]; len(enabled) > 0 && !ok {
+			continue
+		}
+		agents = append(agents, f.factory())
+	}
+
+	for _, path := range splitList(*o.ExternalAgents) {
+		agents = append(agents, NewExternalAgent(path))
+	}
+
+	return agents, nil
+}
+
+// AttachAgent registers agent with the session and subscribes its session
+// lifecycle hooks.
+func AttachAgent(s *core.Session, agent Agent) error {
+	if err := agent.Register(s); err != nil {
+		return err
+	}
+
+	if h, ok := agent.(SessionStartHandler); ok {
+		// Start handlers run synchronously, so that they are done before the
+		// first target is published.
+		if err := s.EventBus.Subscribe(core.SessionStart, h.OnSessionStart); err != nil {
+			return err
+		}
+	}
+	if h, ok := agent.(SessionEndHandler); ok {
+		if err := s.EventBus.SubscribeAsync(core.SessionEnd, h.OnSessionEnd, false); err != nil {
+			return err
+		}
+	}
+	if h, ok := agent.(SessionAbortHandler); ok {
+		if err := s.EventBus.Subscribe(core.SessionAbort, h.OnSessionAbort); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func agentSet(list string) (map[string]struct{}, error) {
+	set := make(map[string]struct{})
+	for _, name := range splitList(list) {
+		name = strings.TrimPrefix(name, "agent:")
+		if !isRegisteredAgent(name) {
+			return nil, fmt.Errorf("Unknown agent %s (available: %s)", name, strings.Join(AgentNames(), ", "))
+		}
+		set[name] = struct{}{}
+	}
+	return set, nil
+}
+
+func isRegisteredAgent(name string) bool {
+	for _, f := range registry {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
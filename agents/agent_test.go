@@ -0,0 +1,44 @@
+package agents
+
+import (
+	"testing"
+	"time"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+type slowStartAgent struct {
+	started bool
+}
+
+func (a *slowStartAgent) ID() string {
+	return "agent:slow_start"
+}
+
+func (a *slowStartAgent) Register(s *core.Session) error {
+	return nil
+}
+
+func (a *slowStartAgent) OnSessionStart() {
+	time.Sleep(50 * time.Millisecond)
+	a.started = true
+}
+
+func TestAttachAgentSessionStart(t *testing.T) {
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	options.OutDir = &outDir
+	s, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agent := &slowStartAgent{}
+	if err := AttachAgent(s, agent); err != nil {
+		t.Fatal(err)
+	}
+	s.EventBus.Publish(core.SessionStart)
+	if !agent.started {
+		t.Error("OnSessionStart didn't finish before the session start was published")
+	}
+}
@@ -0,0 +1,829 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+var errDevtoolsClosed = errors.New("devtools connection closed")
+
+type devtoolsError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *devtoolsError) Error() string {
+	return fmt.Sprintf("devtools error %d: %s", e.Code, e.Message)
+}
+
+type devtoolsRequest struct {
+	ID        int64       `json:"id"`
+	SessionID string      `json:"sessionId,omitempty"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+}
+
+type devtoolsMessage struct {
+	ID        int64           `json:"id,omitempty"`
+	SessionID string          `json:"sessionId,omitempty"`
+	Method    string          `json:"method,omitempty"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Error     *devtoolsError  `json:"error,omitempty"`
+}
+
+// devtoolsConn is a connection to the browser endpoint of the Chrome
+// DevTools protocol. Page targets are attached in flat mode, so commands and
+// events for all tabs are multiplexed over this single connection and told
+// apart by their session ID.
+type devtoolsConn struct {
+	ws       *websocket.Conn
+	sendLock sync.Mutex
+	lock     sync.Mutex
+	nextID   int64
+	pending  map[int64]chan devtoolsMessage
+	events   map[string]chan devtoolsMessage
+	closed   chan struct{}
+}
+
+func dialDevtools(wsURL string) (*devtoolsConn, error) {
+	ws, err := websocket.Dial(wsURL, "", "http://127.0.0.1/")
+	if err != nil {
+		return nil, err
+	}
+	ws.MaxPayloadBytes = 256 << 20
+
+	c := &devtoolsConn{
+		ws:      ws,
+		pending: make(map[int64]chan devtoolsMessage),
+		events:  make(map[string]chan devtoolsMessage),
+		closed:  make(chan struct{}),
+	}
+	go c.readLoop()
+
+	return c, nil
+}
+
+func (c *devtoolsConn) readLoop() {
+	defer close(c.closed)
+	for {
+		var msg devtoolsMessage
+		if err := websocket.JSON.Receive(c.ws, &msg); err != nil {
+			if err == websocket.ErrFrameTooLarge {
+				continue
+			}
+			return
+		}
+
+		c.lock.Lock()
+		if msg.ID != 0 {
+			ch, ok := c.pending[msg.ID]
+			delete(c.pending, msg.ID)
+			c.lock.Unlock()
+			if ok {
+				ch <- msg
+			}
+			continue
+		}
+		ch, ok := c.events[msg.SessionID]
+		c.lock.Unlock()
+		if ok {
+			select {
+			case ch <- msg:
+			default:
+			}
+		}
+	}
+}
+
+func (c *devtoolsConn) Call(ctx context.Context, sessionID string, method string, params interface{}, result interface{}) error {
+	ch := make(chan devtoolsMessage, 1)
+	c.lock.Lock()
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = ch
+	c.lock.Unlock()
+
+	c.sendLock.Lock()
+	err := websocket.JSON.Send(c.ws, devtoolsRequest{ID: id, SessionID: sessionID, Method: method, Params: params})
+	c.sendLock.Unlock()
+	if err != nil {
+		c.forget(id)
+		return err
+	}
+
+	select {
+	case msg := <-ch:
+		if msg.Error != nil {
+			return msg.Error
+		}
+		if result != nil && len(msg.Result) > 0 {
+			return json.Unmarshal(msg.Result, result)
+		}
+		return nil
+	case <-ctx.Done():
+		c.forget(id)
+		return ctx.Err()
+	case <-c.closed:
+		return errDevtoolsClosed
+	}
+}
+
+func (c *devtoolsConn) forget(id int64) {
+	c.lock.Lock()
+	delete(c.pending, id)
+	c.lock.Unlock()
+}
+
+func (c *devtoolsConn) Subscribe(sessionID string) chan devtoolsMessage {
+	ch := make(chan devtoolsMessage, 1024)
+	c.lock.Lock()
+	c.events[sessionID] = ch
+	c.lock.Unlock()
+	return ch
+}
+
+func (c *devtoolsConn) Unsubscribe(sessionID string) {
+	c.lock.Lock()
+	delete(c.events, sessionID)
+	c.lock.Unlock()
+}
+
+func (c *devtoolsConn) IsClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *devtoolsConn) Close() error {
+	return c.ws.Close()
+}
+
+type chromeBrowser struct {
+	cmd  *exec.Cmd
+	conn *devtoolsConn
+}
+
+// launchChrome starts a Chrome/Chromium process with remote debugging
+// enabled and connects to its DevTools endpoint.
+func launchChrome(path string, args []string, timeout time.Duration) (*chromeBrowser, error) {
+	cmd := exec.Command(path, append(args, "--remote-debugging-port=0", "--remote-allow-origins=*", "about:blank")...)
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	b := &chromeBrowser{cmd: cmd}
+	wsURL, err := readDevtoolsURL(stderr, timeout)
+	if err != nil {
+		b.kill()
+		return nil, err
+	}
+
+	if b.conn, err = dialDevtools(wsURL); err != nil {
+		b.kill()
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func readDevtoolsURL(r io.Reader, timeout time.Duration) (string, error) {
+	found := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if i := strings.Index(line, "ws://"); i != -1 && strings.Contains(line, "DevTools listening on") {
+				found <- strings.TrimSpace(line[i:])
+				break
+			}
+		}
+		close(found)
+		// Keep draining so the browser never blocks on a full stderr pipe.
+		_, _ = io.Copy(io.Discard, r)
+	}()
+
+	select {
+	case wsURL, ok := <-found:
+		if !ok {
+			return "", errors.New("browser exited before DevTools endpoint was available")
+		}
+		return wsURL, nil
+	case <-time.After(timeout):
+		return "", errors.New("timed out waiting for DevTools endpoint")
+	}
+}
+
+func (b *chromeBrowser) IsAlive() bool {
+	return b.conn != nil && !b.conn.IsClosed()
+}
+
+func (b *chromeBrowser) Close() {
+	if b.conn != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		_ = b.conn.Call(ctx, "", "Browser.close", nil, nil)
+		cancel()
+		_ = b.conn.Close()
+	}
+	b.kill()
+}
+
+func (b *chromeBrowser) kill() {
+	if b.cmd.Process == nil {
+		return
+	}
+	_ = b.cmd.Process.Kill()
+	_ = b.cmd.Wait()
+}
+
+// chromeTab is a page target living in its own browser context, which keeps
+// cookies and storage isolated between tabs and is disposed with the tab.
+type chromeTab struct {
+	browser   *chromeBrowser
+	contextID string
+	targetID  string
+	sessionID string
+	events    chan devtoolsMessage
+	done      chan struct{}
+
+	lock          sync.Mutex
+	authorization func(url string) string
+	fetchEnabled  bool
+}
+
+func (b *chromeBrowser) NewTab(ctx context.Context) (*chromeTab, error) {
+	t := &chromeTab{browser: b}
+
+	var browserContext struct {
+		BrowserContextID string `json:"browserContextId"`
+	}
+	if err := b.conn.Call(ctx, "", "Target.createBrowserContext", map[string]interface{}{"disposeOnDetach": true}, &browserContext); err != nil {
+		return nil, err
+	}
+	t.contextID = browserContext.BrowserContextID
+
+	var target struct {
+		TargetID string `json:"targetId"`
+	}
+	if err := b.conn.Call(ctx, "", "Target.createTarget", map[string]interface{}{"url": "about:blank", "browserContextId": t.contextID}, &target); err != nil {
+		t.Close()
+		return nil, err
+	}
+	t.targetID = target.TargetID
+
+	var attached struct {
+		SessionID string `json:"sessionId"`
+	}
+	if err := b.conn.Call(ctx, "", "Target.attachToTarget", map[string]interface{}{"targetId": t.targetID, "flatten": true}, &attached); err != nil {
+		t.Close()
+		return nil, err
+	}
+	t.sessionID = attached.SessionID
+	t.events = make(chan devtoolsMessage, 1024)
+	t.done = make(chan struct{})
+	go t.dispatchEvents(b.conn.Subscribe(t.sessionID))
+
+	for _, method := range []string{"Page.enable", "Runtime.enable"} {
+		if err := t.call(ctx, method, nil, nil); err != nil {
+			t.Close()
+			return nil, err
+		}
+	}
+	if err := t.call(ctx, "Page.setLifecycleEventsEnabled", map[string]interface{}{"enabled": true}, nil); err != nil {
+		t.Close()
+		return nil, err
+	}
+	_ = t.call(ctx, "Security.setIgnoreCertificateErrors", map[string]interface{}{"ignore": true}, nil)
+
+	return t, nil
+}
+
+func (t *chromeTab) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	return t.browser.conn.Call(ctx, t.sessionID, method, params, result)
+}
+
+// dispatchEvents answers paused requests as soon as they arrive, since the
+// page doesn't load until they are continued, and passes other events on to
+// the events channel.
+func (t *chromeTab) dispatchEvents(events chan devtoolsMessage) {
+	for {
+		select {
+		case msg := <-events:
+			if msg.Method == "Fetch.requestPaused" {
+				go t.continueRequest(msg.Params)
+				continue
+			}
+			select {
+			case t.events <- msg:
+			default:
+			}
+		case <-t.done:
+			return
+		case <-t.browser.conn.closed:
+			return
+		}
+	}
+}
+
+// continueRequest continues a paused request, adding an Authorization header
+// if credentials are configured for its URL.
+func (t *chromeTab) continueRequest(params json.RawMessage) {
+	var event struct {
+		RequestID string `json:"requestId"`
+		Request   struct {
+			URL     string            `json:"url"`
+			Headers map[string]string `json:"headers"`
+		} `json:"request"`
+	}
+	if err := json.Unmarshal(params, &event); err != nil {
+		return
+	}
+
+	continueParams := map[string]interface{}{"requestId": event.RequestID}
+	t.lock.Lock()
+	authorization := t.authorization
+	t.lock.Unlock()
+	if authorization != nil {
+		if value := authorization(event.Request.URL); value != "" {
+			headers := []map[string]string{{"name": "Authorization", "value": value}}
+			for name, value := range event.Request.Headers {
+				if !strings.EqualFold(name, "Authorization") {
+					headers = append(headers, map[string]string{"name": name, "value": value})
+				}
+			}
+			continueParams["headers"] = headers
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_ = t.call(ctx, "Fetch.continueRequest", continueParams, nil)
+}
+
+// setRequestOptions sends headers with every request of the tab, sets cookies
+// in its browser context and adds the Authorization header returned by
+// authorization to matching requests.
+func (t *chromeTab) setRequestOptions(ctx context.Context, headers map[string]string, cookies []*http.Cookie, authorization func(url string) string) error {
+	if len(headers) > 0 || len(cookies) > 0 {
+		if err := t.call(ctx, "Network.enable", nil, nil); err != nil {
+			return err
+		}
+	}
+	if len(headers) > 0 {
+		if err := t.call(ctx, "Network.setExtraHTTPHeaders", map[string]interface{}{"headers": headers}, nil); err != nil {
+			return err
+		}
+	}
+	if len(cookies) > 0 {
+		if err := t.call(ctx, "Network.setCookies", map[string]interface{}{"cookies": devtoolsCookies(cookies)}, nil); err != nil {
+			return err
+		}
+	}
+
+	t.lock.Lock()
+	t.authorization = authorization
+	enable := authorization != nil && !t.fetchEnabled
+	t.lock.Unlock()
+	if enable {
+		err := t.call(ctx, "Fetch.enable", map[string]interface{}{
+			"patterns": []map[string]string{{"urlPattern": "*", "requestStage": "Request"}},
+		}, nil)
+		if err != nil {
+			return err
+		}
+		t.lock.Lock()
+		t.fetchEnabled = true
+		t.lock.Unlock()
+	}
+
+	return nil
+}
+
+// devtoolsCookies converts cookies to Network.CookieParam values. Cookies
+// with a Domain starting with a dot match subdomains, others only the host.
+func devtoolsCookies(cookies []*http.Cookie) []map[string]interface{} {
+	var params []map[string]interface{}
+	for _, c := range cookies {
+		path := c.Path
+		if path == "" {
+			path = "/"
+		}
+		param := map[string]interface{}{
+			"name":     c.Name,
+			"value":    c.Value,
+			"path":     path,
+			"secure":   c.Secure,
+			"httpOnly": c.HttpOnly,
+		}
+		if strings.HasPrefix(c.Domain, ".") {
+			param["domain"] = c.Domain
+		} else {
+			scheme := "http"
+			if c.Secure {
+				scheme = "https"
+			}
+			param["url"] = scheme + "://" + c.Domain + path
+		}
+		if !c.Expires.IsZero() {
+			param["expires"] = c.Expires.Unix()
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+func (t *chromeTab) IsAlive() bool {
+	return t.browser.IsAlive()
+}
+
+func (t *chromeTab) Close() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if t.sessionID != "" {
+		t.browser.conn.Unsubscribe(t.sessionID)
+		close(t.done)
+	}
+	if t.targetID != "" {
+		_ = t.browser.conn.Call(ctx, "", "Target.closeTarget", map[string]interface{}{"targetId": t.targetID}, nil)
+	}
+	if t.contextID != "" {
+		_ = t.browser.conn.Call(ctx, "", "Target.disposeBrowserContext", map[string]interface{}{"browserContextId": t.contextID}, nil)
+	}
+}
+
+type screenshotOptions struct {
+	Width          int
+	Height         int
+	UserAgent      string
+	Headers        map[string]string
+	Cookies        []*http.Cookie
+	Authorization  func(url string) string
+	WaitFor        string
+	Selector       string
+	Delay          time.Duration
+	Format         string
+	Quality        int
+	FullPage       bool
+	Element        string
+	ThumbnailWidth int
+}
+
+type screenshotClip struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Scale  float64 `json:"scale"`
+}
+
+// maxScreenshotHeight is the largest texture Chrome is able to capture in
+// one go. Taller full-page screenshots are cut off at this height.
+const maxScreenshotHeight = 16384
+
+// Screenshot navigates the tab to url, waits for the configured conditions
+// and returns the captured image along with a thumbnail of the viewport if
+// opts.ThumbnailWidth is set.
+func (t *chromeTab) Screenshot(ctx context.Context, url string, opts screenshotOptions) ([]byte, []byte, error) {
+	t.drainEvents()
+
+	err := t.call(ctx, "Emulation.setDeviceMetricsOverride", map[string]interface{}{
+		"width":             opts.Width,
+		"height":            opts.Height,
+		"deviceScaleFactor": 1,
+		"mobile":            false,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.UserAgent != "" {
+		if err := t.call(ctx, "Emulation.setUserAgentOverride", map[string]interface{}{"userAgent": opts.UserAgent}, nil); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := t.setRequestOptions(ctx, opts.Headers, opts.Cookies, opts.Authorization); err != nil {
+		return nil, nil, err
+	}
+
+	var navigation struct {
+		FrameID   string `json:"frameId"`
+		LoaderID  string `json:"loaderId"`
+		ErrorText string `json:"errorText"`
+	}
+	if err := t.call(ctx, "Page.navigate", map[string]interface{}{"url": url}, &navigation); err != nil {
+		return nil, nil, err
+	}
+	if navigation.ErrorText != "" {
+		return nil, nil, errors.New(navigation.ErrorText)
+	}
+
+	if err := t.waitForPage(ctx, opts.WaitFor, navigation.FrameID, navigation.LoaderID); err != nil {
+		return nil, nil, err
+	}
+
+	if opts.Selector != "" {
+		if err := t.waitForSelector(ctx, opts.Selector); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if opts.Delay > 0 {
+		select {
+		case <-time.After(opts.Delay):
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+
+	clip, err := t.screenshotClip(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	image, err := t.capture(ctx, opts, clip)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.ThumbnailWidth <= 0 {
+		return image, nil, nil
+	}
+
+	thumbnail, err := t.capture(ctx, opts, &screenshotClip{
+		Width:  float64(opts.Width),
+		Height: float64(opts.Height),
+		Scale:  float64(opts.ThumbnailWidth) / float64(opts.Width),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return image, thumbnail, nil
+}
+
+// screenshotClip returns the area to capture for the configured screenshot
+// mode, or nil to capture the viewport.
+func (t *chromeTab) screenshotClip(ctx context.Context, opts screenshotOptions) (*screenshotClip, error) {
+	if opts.Element != "" {
+		selectorJSON, _ := json.Marshal(opts.Element)
+		expression := fmt.Sprintf(`(function() {
+			var e = document.querySelector(%s);
+			if (!e) { return null; }
+			var r = e.getBoundingClientRect();
+			return {x: r.left + window.scrollX, y: r.top + window.scrollY, width: r.width, height: r.height};
+		})()`, selectorJSON)
+		var evaluation struct {
+			Result struct {
+				Value *screenshotClip `json:"value"`
+			} `json:"result"`
+		}
+		if err := t.call(ctx, "Runtime.evaluate", map[string]interface{}{"expression": expression, "returnByValue": true}, &evaluation); err != nil {
+			return nil, err
+		}
+		if clip := evaluation.Result.Value; clip != nil && clip.Width > 0 && clip.Height > 0 {
+			clip.Scale = 1
+			return clip, nil
+		}
+		// Fall back to the configured page mode if the element is missing.
+	}
+
+	if !opts.FullPage {
+		return nil, nil
+	}
+
+	var metrics struct {
+		ContentSize    screenshotClip `json:"contentSize"`
+		CSSContentSize screenshotClip `json:"cssContentSize"`
+	}
+	if err := t.call(ctx, "Page.getLayoutMetrics", nil, &metrics); err != nil {
+		return nil, err
+	}
+	size := metrics.CSSContentSize
+	if size.Width == 0 || size.Height == 0 {
+		size = metrics.ContentSize
+	}
+
+	clip := &screenshotClip{Width: size.Width, Height: size.Height, Scale: 1}
+	if clip.Width < float64(opts.Width) {
+		clip.Width = float64(opts.Width)
+	}
+	if clip.Height < float64(opts.Height) {
+		clip.Height = float64(opts.Height)
+	}
+	if clip.Height > maxScreenshotHeight {
+		clip.Height = maxScreenshotHeight
+	}
+
+	return clip, nil
+}
+
+func (t *chromeTab) capture(ctx context.Context, opts screenshotOptions, clip *screenshotClip) ([]byte, error) {
+	params := map[string]interface{}{"format": opts.Format}
+	if opts.Format != "png" {
+		params["quality"] = opts.Quality
+	}
+	if clip != nil {
+		params["clip"] = clip
+		params["captureBeyondViewport"] = true
+	}
+
+	var screenshot struct {
+		Data string `json:"data"`
+	}
+	if err := t.call(ctx, "Page.captureScreenshot", params, &screenshot); err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(screenshot.Data)
+}
+
+func (t *chromeTab) drainEvents() {
+	for {
+		select {
+		case <-t.events:
+		default:
+			return
+		}
+	}
+}
+
+func (t *chromeTab) waitForPage(ctx context.Context, condition string, frameID string, loaderID string) error {
+	for {
+		select {
+		case msg := <-t.events:
+			switch msg.Method {
+			case "Page.loadEventFired":
+				if condition == "load" {
+					return nil
+				}
+			case "Page.domContentEventFired":
+				if condition == "domcontentloaded" {
+					return nil
+				}
+			case "Page.lifecycleEvent":
+				var event struct {
+					FrameID  string `json:"frameId"`
+					LoaderID string `json:"loaderId"`
+					Name     string `json:"name"`
+				}
+				if err := json.Unmarshal(msg.Params, &event); err != nil {
+					continue
+				}
+				if event.FrameID == frameID && event.LoaderID == loaderID && event.Name == "networkIdle" && condition == "networkidle" {
+					return nil
+				}
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.browser.conn.closed:
+			return errDevtoolsClosed
+		}
+	}
+}
+
+func (t *chromeTab) waitForSelector(ctx context.Context, selector string) error {
+	selectorJSON, _ := json.Marshal(selector)
+	expression := fmt.Sprintf("document.querySelector(%s) !== null", selectorJSON)
+	for {
+		var evaluation struct {
+			Result struct {
+				Value bool `json:"value"`
+			} `json:"result"`
+		}
+		if err := t.call(ctx, "Runtime.evaluate", map[string]interface{}{"expression": expression, "returnByValue": true}, &evaluation); err != nil {
+			return err
+		}
+		if evaluation.Result.Value {
+			return nil
+		}
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// chromeTabPool hands out up to size tabs of a single long-lived browser
+// process. The browser is launched lazily and relaunched if it dies. Tabs
+// aren't reused: every tab lives in a fresh browser context that is disposed
+// on Release, so cookies, storage and credentials of one target never leak
+// into the screenshot of the next.
+type chromeTabPool struct {
+	sync.Mutex
+	chromePath    string
+	args          []string
+	size          int
+	launchTimeout time.Duration
+	browser       *chromeBrowser
+	created       int
+	freed         chan struct{}
+	closed        bool
+}
+
+func newChromeTabPool(chromePath string, args []string, size int, launchTimeout time.Duration) *chromeTabPool {
+	return &chromeTabPool{
+		chromePath:    chromePath,
+		args:          args,
+		size:          size,
+		launchTimeout: launchTimeout,
+		freed:         make(chan struct{}, size),
+	}
+}
+
+func (p *chromeTabPool) Acquire(ctx context.Context) (*chromeTab, error) {
+	for {
+		p.Lock()
+		if p.created < p.size {
+			p.created++
+			p.Unlock()
+			tab, err := p.newTab(ctx)
+			if err != nil {
+				p.free()
+				return nil, err
+			}
+			return tab, nil
+		}
+		p.Unlock()
+
+		select {
+		case <-p.freed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Release closes a tab along with its browser context and frees its slot for
+// the next Acquire.
+func (p *chromeTabPool) Release(tab *chromeTab) {
+	if tab.IsAlive() {
+		tab.Close()
+	}
+	p.free()
+}
+
+func (p *chromeTabPool) free() {
+	p.Lock()
+	p.created--
+	p.Unlock()
+	select {
+	case p.freed <- struct{}{}:
+	default:
+	}
+}
+
+func (p *chromeTabPool) newTab(ctx context.Context) (*chromeTab, error) {
+	p.Lock()
+	if p.closed {
+		p.Unlock()
+		return nil, errors.New("browser is closed")
+	}
+	if p.browser == nil || !p.browser.IsAlive() {
+		if p.browser != nil {
+			p.browser.Close()
+		}
+		browser, err := launchChrome(p.chromePath, p.args, p.launchTimeout)
+		if err != nil {
+			p.browser = nil
+			p.Unlock()
+			return nil, err
+		}
+		p.browser = browser
+	}
+	browser := p.browser
+	p.Unlock()
+
+	return browser.NewTab(ctx)
+}
+
+func (p *chromeTabPool) Close() {
+	p.Lock()
+	defer p.Unlock()
+	p.closed = true
+	if p.browser != nil {
+		p.browser.Close()
+		p.browser = nil
+	}
+}
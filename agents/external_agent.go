@@ -0,0 +1,224 @@
+package agents
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+type externalRequest struct {
+	Event  string          `json:"event"`
+	OutDir string          `json:"outDir"`
+	Page   json.RawMessage `json:"page"`
+}
+
+type externalResponse struct {
+	Tags  []core.Tag  `json:"tags"`
+	Notes []core.Note `json:"notes"`
+}
+
+// ExternalAgent hands responsive pages to an executable. The executable is
+// started once per session and speaks JSON lines: for every page it reads a
+// request such as
+//
+//	{"event":"url:responsive","outDir":"/path/to/out","page":{...}}
+//
+// from standard input and must answer with exactly one line on standard
+// output, for example
+//
+//	{"tags":[{"text":"Admin panel","type":"warning"}],"notes":[{"text":"...","type":"info"}]}
+//
+// Paths in the page are relative to outDir. Requests are sent one at a time.
+// Standard input is closed when the session ends. An executable that doesn't
+// answer within -agent-timeout is killed.
+type ExternalAgent struct {
+	session   *core.Session
+	path      string
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	responses chan []byte
+	readers   sync.WaitGroup
+	lock      sync.Mutex
+	failed    bool
+}
+
+func NewExternalAgent(path string) *ExternalAgent {
+	return &ExternalAgent{
+		path: path,
+	}
+}
+
+func (ea *ExternalAgent) ID() string {
+	name := strings.TrimSuffix(filepath.Base(ea.path), filepath.Ext(ea.path))
+	return "agent:external:" + name
+}
+
+func (ea *ExternalAgent) Register(s *core.Session) error {
+	ea.session = s
+
+	ea.cmd = exec.Command(ea.path)
+	stdin, err := ea.cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := ea.cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := ea.cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err := ea.cmd.Start(); err != nil {
+		return err
+	}
+	ea.stdin = stdin
+	ea.responses = make(chan []byte, 1)
+
+	ea.readers.Add(2)
+	go ea.readResponses(stdout)
+	go ea.logStderr(stderr)
+
+	return s.SubscribePageHandler(ea.OnURLResponsive)
+}
+
+func (ea *ExternalAgent) OnURLResponsive(url string) {
+	ea.session.Out.Debug("[%s] Received new responsive URL %s\n", ea.ID(), url)
+	page := ea.session.GetPage(url)
+	if page == nil {
+		ea.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	ea.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer ea.session.WaitGroup.Done()
+		defer ea.session.PageTaskDone(page)
+		response, err := ea.process(page)
+		if err != nil {
+			ea.session.Out.Error("[%s] Unable to process %s: %s\n", ea.ID(), page.URL, err)
+			return
+		}
+		for _, tag := range response.Tags {
+			page.AddTag(tag.Text, tag.Type, tag.Link)
+		}
+		for _, note := range response.Notes {
+			page.AddNote(note.Text, note.Type)
+		}
+	}(page)
+}
+
+func (ea *ExternalAgent) OnSessionEnd() {
+	ea.session.Out.Debug("[%s] Received SessionEnd event\n", ea.ID())
+	ea.lock.Lock()
+	defer ea.lock.Unlock()
+	_ = ea.stdin.Close()
+	if !ea.failed {
+		// Children of a killed executable may hold on to its output, so
+		// only wait for the output of executables that exit on their own.
+		ea.readers.Wait()
+	}
+	if err := ea.cmd.Wait(); err != nil && !ea.failed {
+		ea.session.Out.Error("[%s] Exited with error: %s\n", ea.ID(), err)
+	}
+}
+
+// OnSessionAbort kills the executable when the scan is aborted.
+func (ea *ExternalAgent) OnSessionAbort() {
+	if ea.cmd.Process != nil {
+		_ = ea.cmd.Process.Kill()
+	}
+}
+
+func (ea *ExternalAgent) process(page *core.Page) (*externalResponse, error) {
+	pageJSON, err := page.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	outDir, err := filepath.Abs(*ea.session.Options.OutDir)
+	if err != nil {
+		return nil, err
+	}
+	request, err := json.Marshal(externalRequest{
+		Event:  core.URLResponsive,
+		OutDir: outDir,
+		Page:   pageJSON,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ea.lock.Lock()
+	defer ea.lock.Unlock()
+	if ea.failed {
+		return &externalResponse{}, nil
+	}
+
+	// Drop anything the executable wrote without being asked, so that it
+	// isn't taken for the response to this request.
+	select {
+	case <-ea.responses:
+	default:
+	}
+
+	if _, err := ea.stdin.Write(append(request, '\n')); err != nil {
+		ea.fail()
+		return nil, err
+	}
+
+	timeout := time.Duration(*ea.session.Options.AgentTimeout) * time.Millisecond
+	select {
+	case line, ok := <-ea.responses:
+		if !ok {
+			ea.fail()
+			return nil, fmt.Errorf("agent exited")
+		}
+		var response externalResponse
+		if err := json.Unmarshal(line, &response); err != nil {
+			return nil, fmt.Errorf("invalid response: %s", err)
+		}
+		return &response, nil
+	case <-time.After(timeout):
+		ea.fail()
+		return nil, fmt.Errorf("no response within %v", timeout)
+	}
+}
+
+// fail kills the executable and stops sending it pages. It must be called
+// with the lock held.
+func (ea *ExternalAgent) fail() {
+	ea.failed = true
+	_ = ea.cmd.Process.Kill()
+	ea.session.Out.Error("[%s] Agent stopped, remaining pages will not be processed by it\n", ea.ID())
+}
+
+func (ea *ExternalAgent) readResponses(stdout io.Reader) {
+	defer ea.readers.Done()
+	defer close(ea.responses)
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := append([]byte(nil), scanner.Bytes()...)
+		select {
+		case ea.responses <- line:
+		default:
+			ea.session.Out.Debug("[%s] Ignoring unexpected output: %s\n", ea.ID(), line)
+		}
+	}
+}
+
+func (ea *ExternalAgent) logStderr(stderr io.Reader) {
+	defer ea.readers.Done()
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		ea.session.Out.Debug("[%s] %s\n", ea.ID(), scanner.Text())
+	}
+}
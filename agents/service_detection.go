@@ -0,0 +1,245 @@
+package agents
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// maxBannerLength limits how much of a banner is read and recorded.
+const maxBannerLength = 1024
+
+// binaryProbe is sent to ports that answer neither an HTTP request nor with a
+// banner of their own.
+type binaryProbe struct {
+	name    string
+	payload []byte
+	matches func(resp []byte) bool
+}
+
+var binaryProbes = []binaryProbe{
+	{
+		// X.224 connection request with an RDP negotiation request.
+		name:    "rdp",
+		payload: []byte("\x03\x00\x00\x13\x0e\xe0\x00\x00\x00\x00\x00\x01\x00\x08\x00\x03\x00\x00\x00"),
+		matches: func(resp []byte) bool {
+			return len(resp) >= 4 && resp[0] == 0x03 && resp[1] == 0x00
+		},
+	},
+	{
+		// SSLRequest, answered with a single S or N.
+		name:    "postgresql",
+		payload: []byte("\x00\x00\x00\x08\x04\xd2\x16\x2f"),
+		matches: func(resp []byte) bool {
+			return len(resp) == 1 && (resp[0] == 'S' || resp[0] == 'N')
+		},
+	},
+	{
+		// TDS pre-login packet.
+		name: "mssql",
+		payload: []byte("\x12\x01\x00\x34\x00\x00\x00\x00\x00\x00\x15\x00\x06\x01\x00\x1b" +
+			"\x00\x01\x02\x00\x1c\x00\x0c\x03\x00\x28\x00\x04\xff\x08\x00\x01" +
+			"\x55\x00\x00\x00\x4d\x53\x53\x51\x4c\x53\x65\x72\x76\x65\x72\x00" +
+			"\x48\x0f\x00\x00"),
+		matches: func(resp []byte) bool {
+			return len(resp) >= 8 && resp[0] == 0x04 && resp[1] == 0x01
+		},
+	},
+}
+
+// silentServicePorts names the services usually found on ports that don't
+// answer any probe.
+var silentServicePorts = map[int]string{
+	135:   "msrpc",
+	139:   "netbios-ssn",
+	389:   "ldap",
+	445:   "microsoft-ds",
+	1433:  "mssql",
+	1521:  "oracle",
+	2049:  "nfs",
+	3306:  "mysql",
+	3389:  "rdp",
+	5432:  "postgresql",
+	5672:  "amqp",
+	6379:  "redis",
+	9092:  "kafka",
+	27017: "mongodb",
+}
+
+// detectService finds out what is listening on an open port. It returns the
+// URL to request for web servers, or the service found otherwise. Ports that
+// don't answer any probe are treated as web servers unless the port is known
+// for a service that doesn't answer them.
+func detectService(s *core.Session, host string, port int) (string, *core.Service) {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	timeout := time.Duration(*s.Options.BannerTimeout) * time.Millisecond
+
+	tlsConn, err := dialTLS(s, host, addr)
+	if err == nil {
+		resp := probeConn(tlsConn, host, timeout)
+		if name, banner := identifyResponse(resp, port); name != "" && name != "http" {
+			return "", &core.Service{Host: host, Port: port, Name: name, TLS: true, Banner: banner}
+		}
+		return HostAndPortToURL(host, port, "https"), nil
+	}
+
+	// Plain HTTP servers answer the TLS client hello with an HTTP error.
+	var recordErr tls.RecordHeaderError
+	if errors.As(err, &recordErr) && bytes.HasPrefix(recordErr.RecordHeader[:], []byte("HTTP/")) {
+		return HostAndPortToURL(host, port, "http"), nil
+	}
+
+	s.Throttle(host)
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err == nil {
+		resp := probeConn(conn, host, timeout)
+		switch name, banner := identifyResponse(resp, port); name {
+		case "http":
+			if bytes.Contains(resp, []byte("plain HTTP request was sent to HTTPS port")) {
+				return HostAndPortToURL(host, port, "https"), nil
+			}
+			return HostAndPortToURL(host, port, "http"), nil
+		case "":
+		default:
+			return "", &core.Service{Host: host, Port: port, Name: name, Banner: banner}
+		}
+	}
+
+	for _, probe := range binaryProbes {
+		if s.Stopped() {
+			break
+		}
+		s.Throttle(host)
+		conn, err := net.DialTimeout("tcp", addr, timeout)
+		if err != nil {
+			continue
+		}
+		resp := exchange(conn, probe.payload, timeout)
+		if probe.matches(resp) {
+			return "", &core.Service{Host: host, Port: port, Name: probe.name}
+		}
+	}
+
+	if name, ok := silentServicePorts[port]; ok {
+		return "", &core.Service{Host: host, Port: port, Name: name}
+	}
+	return HostAndPortToURL(host, port, "http"), nil
+}
+
+func dialTLS(s *core.Session, host string, addr string) (net.Conn, error) {
+	s.Throttle(host)
+	dialer := &net.Dialer{Timeout: time.Duration(*s.Options.HTTPTimeout) * time.Millisecond}
+	return tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{
+		InsecureSkipVerify: true,
+	})
+}
+
+// probeConn sends an HTTP request on conn and returns what the server sent
+// back. Services that speak first answer with their banner instead.
+func probeConn(conn net.Conn, host string, timeout time.Duration) []byte {
+	return exchange(conn, []byte(fmt.Sprintf("GET / HTTP/1.0\r\nHost: %s\r\nUser-Agent: %s\r\n\r\n", host, RandomUserAgent())), timeout)
+}
+
+// exchange writes payload to conn and reads the response until timeout,
+// maxBannerLength bytes or the end of the connection. It closes conn.
+func exchange(conn net.Conn, payload []byte, timeout time.Duration) []byte {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+	if _, err := conn.Write(payload); err != nil {
+		return nil
+	}
+
+	buf := make([]byte, maxBannerLength)
+	n, _ := io.ReadAtLeast(conn, buf, len(buf))
+	return buf[:n]
+}
+
+// identifyResponse names the protocol of a response to probeConn, which is
+// "http" for web servers, and returns the banner to record. It returns an
+// empty name if there was no response.
+func identifyResponse(resp []byte, port int) (string, string) {
+	if len(resp) == 0 {
+		return "", ""
+	}
+	banner := bannerLine(resp)
+	lower := strings.ToLower(banner)
+
+	switch {
+	case bytes.HasPrefix(resp, []byte("HTTP/")):
+		return "http", ""
+	case bytes.HasPrefix(resp, []byte("SSH-")):
+		return "ssh", banner
+	case bytes.HasPrefix(resp, []byte("220")):
+		if strings.Contains(lower, "ftp") {
+			return "ftp", banner
+		}
+		if strings.Contains(lower, "smtp") || strings.Contains(lower, "mail") || port == 25 || port == 465 || port == 587 {
+			return "smtp", banner
+		}
+		return "ftp", banner
+	case bytes.HasPrefix(resp, []byte("+OK")):
+		return "pop3", banner
+	case bytes.HasPrefix(resp, []byte("* OK")):
+		return "imap", banner
+	case bytes.HasPrefix(resp, []byte("RFB ")):
+		return "vnc", banner
+	case bytes.HasPrefix(resp, []byte("@RSYNCD")):
+		return "rsync", banner
+	case bytes.HasPrefix(resp, []byte("AMQP")):
+		return "amqp", banner
+	case isMySQLHandshake(resp):
+		if resp[4] == 0x0a {
+			// The server version follows the protocol version.
+			banner = bannerLine(bytes.SplitN(resp[5:], []byte{0}, 2)[0])
+		} else {
+			banner = bannerLine(resp[7:])
+		}
+		return "mysql", banner
+	case bytes.HasPrefix(resp, []byte("-ERR")) || bytes.HasPrefix(resp, []byte("-NOAUTH")) || bytes.HasPrefix(resp, []byte("-DENIED")) || bytes.HasPrefix(resp, []byte("$-1")):
+		return "redis", banner
+	case bytes.HasPrefix(resp, []byte("ERROR\r\n")):
+		return "memcached", banner
+	case resp[0] == 0xff:
+		return "telnet", banner
+	}
+	return "unknown", banner
+}
+
+// isMySQLHandshake reports whether resp is the initial handshake packet of a
+// MySQL or MariaDB server, or the error packet they send to hosts they don't
+// accept.
+func isMySQLHandshake(resp []byte) bool {
+	if len(resp) < 6 || resp[3] != 0 {
+		return false
+	}
+	length := int(resp[0]) | int(resp[1])<<8 | int(resp[2])<<16
+	if length+4 > len(resp) && len(resp) < maxBannerLength {
+		return false
+	}
+	if resp[4] == 0xff {
+		return bytes.Contains(resp, []byte("MySQL")) || bytes.Contains(resp, []byte("MariaDB"))
+	}
+	return resp[4] == 0x0a
+}
+
+// bannerLine returns the first line of resp with unprintable characters
+// removed.
+func bannerLine(resp []byte) string {
+	if i := bytes.IndexAny(resp, "\r\n"); i >= 0 {
+		resp = resp[:i]
+	}
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return -1
+		}
+		return r
+	}, string(resp))
+}
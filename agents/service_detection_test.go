@@ -0,0 +1,50 @@
+package agents
+
+import "testing"
+
+// mysqlPacket returns a MySQL packet with sequence number 0 and payload.
+func mysqlPacket(payload string) []byte {
+	n := len(payload)
+	return append([]byte{byte(n), byte(n >> 8), byte(n >> 16), 0}, payload...)
+}
+
+func TestIdentifyResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    []byte
+		port    int
+		service string
+		banner  string
+	}{
+		{"empty", nil, 22, "", ""},
+		{"http", []byte("HTTP/1.1 400 Bad Request\r\nServer: nginx\r\n\r\n"), 8080, "http", ""},
+		{"ssh", []byte("SSH-2.0-OpenSSH_8.9p1 Ubuntu-3\r\n"), 22, "ssh", "SSH-2.0-OpenSSH_8.9p1 Ubuntu-3"},
+		{"ftp", []byte("220 ProFTPD Server ready.\r\n"), 21, "ftp", "220 ProFTPD Server ready."},
+		{"ftp on unknown port", []byte("220 Welcome\r\n"), 2121, "ftp", "220 Welcome"},
+		{"smtp", []byte("220 mx.example.com ESMTP Postfix\r\n"), 2525, "smtp", "220 mx.example.com ESMTP Postfix"},
+		{"smtp by port", []byte("220 mx.example.com ready\r\n"), 587, "smtp", "220 mx.example.com ready"},
+		{"pop3", []byte("+OK Dovecot ready.\r\n"), 110, "pop3", "+OK Dovecot ready."},
+		{"imap", []byte("* OK [CAPABILITY IMAP4rev1] Dovecot ready.\r\n"), 143, "imap", "* OK [CAPABILITY IMAP4rev1] Dovecot ready."},
+		{"vnc", []byte("RFB 003.008\n"), 5900, "vnc", "RFB 003.008"},
+		{"rsync", []byte("@RSYNCD: 31.0\n"), 873, "rsync", "@RSYNCD: 31.0"},
+		{"amqp", []byte("AMQP\x00\x00\x09\x01"), 5672, "amqp", "AMQP"},
+		{"mysql", mysqlPacket("\x0a5.7.33-log\x00\x08\x00\x00\x00abcdefgh\x00"), 3306, "mysql", "5.7.33-log"},
+		{"mysql host not allowed", mysqlPacket("\xffj\x04Host '10.0.0.1' is not allowed to connect to this MySQL server"), 3306, "mysql", "Host '10.0.0.1' is not allowed to connect to this MySQL server"},
+		{"mysql error from something else", mysqlPacket("\xffj\x04Access denied"), 3306, "unknown", "jAccess denied"},
+		{"truncated mysql", []byte{0x4a, 0, 0, 0, 0x0a, '5'}, 3306, "unknown", "J"},
+		{"redis", []byte("-ERR wrong number of arguments for 'get' command\r\n"), 6379, "redis", "-ERR wrong number of arguments for 'get' command"},
+		{"redis with auth", []byte("-NOAUTH Authentication required.\r\n"), 6379, "redis", "-NOAUTH Authentication required."},
+		{"redis missing key", []byte("$-1\r\n"), 6379, "redis", "$-1"},
+		{"memcached", []byte("ERROR\r\n"), 11211, "memcached", "ERROR"},
+		{"telnet", []byte{0xff, 0xfb, 0x01, 0xff, 0xfd, 0x18}, 23, "telnet", ""},
+		{"unknown", []byte("hello\x01world\r\nmore"), 9999, "unknown", "helloworld"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, banner := identifyResponse(tt.resp, tt.port)
+			if service != tt.service || banner != tt.banner {
+				t.Errorf("identifyResponse() = %q, %q, want %q, %q", service, banner, tt.service, tt.banner)
+			}
+		})
+	}
+}
@@ -1,19 +1,84 @@
 package agents
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"sdg-git.solar.local/golang/aquatone/core"
 )
 
+// minScanTimeout is the shortest timeout the port scanner shrinks to, so that
+// a few fast answers don't make slower ports of a host look filtered.
+const minScanTimeout = 100 * time.Millisecond
+
+// hostScanState holds the round-trip time estimate and the connection slots
+// of a scanned host.
+type hostScanState struct {
+	sync.Mutex
+	srtt    time.Duration
+	rttvar  time.Duration
+	samples int
+	slots   chan struct{}
+}
+
+// addSample updates the round-trip time estimate the same way TCP does
+// (RFC 6298).
+func (h *hostScanState) addSample(rtt time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+	if h.samples == 0 {
+		h.srtt = rtt
+		h.rttvar = rtt / 2
+	} else {
+		diff := h.srtt - rtt
+		if diff < 0 {
+			diff = -diff
+		}
+		h.rttvar = (3*h.rttvar + diff) / 4
+		h.srtt = (7*h.srtt + rtt) / 8
+	}
+	h.samples++
+}
+
+// timeout returns the connect timeout for the host, which is max until the
+// host has answered once.
+func (h *hostScanState) timeout(max time.Duration) time.Duration {
+	h.Lock()
+	defer h.Unlock()
+	if h.samples == 0 {
+		return max
+	}
+	timeout := h.srtt + 4*h.rttvar
+	if timeout < minScanTimeout {
+		timeout = minScanTimeout
+	}
+	if timeout > max {
+		timeout = max
+	}
+	return timeout
+}
+
+// TCPPortScanner connects to the ports of every host. Timeouts are shortened
+// per host from the round-trip times of answered connections, ports that
+// don't answer are tried once more with the full -scan-timeout, and the
+// number of concurrent connections to a host is limited with
+// -host-connections.
 type TCPPortScanner struct {
 	session *core.Session
+	lock    sync.Mutex
+	hosts   map[string]*hostScanState
 }
 
 func NewTCPPortScanner() *TCPPortScanner {
-	return &TCPPortScanner{}
+	return &TCPPortScanner{
+		hosts: make(map[string]*hostScanState),
+	}
 }
 
 func (ps *TCPPortScanner) ID() string {
@@ -33,11 +98,64 @@ func (ps *TCPPortScanner) Register(s *core.Session) error {
 
 func (ps *TCPPortScanner) OnHost(host string) {
 	ps.session.Out.Debug("[%s] Received new host: %s\n", ps.ID(), host)
+	if !ps.session.InScopeHost(host) {
+		return
+	}
+
+	var ports []int
 	for _, port := range ps.session.Ports {
+		if !ps.session.InScopePort(host, port) {
+			continue
+		}
+		// Ports scanned in a resumed session aren't scanned again. Open
+		// ones are passed on in case their URLs weren't processed yet.
+		switch ps.session.PortState(host, port) {
+		case "":
+			ports = append(ports, port)
+		case core.PortOpen:
+			ps.session.Out.Debug("[%s] Port %d on %s was found open in resumed session\n", ps.ID(), port, host)
+			ps.session.EventBus.Publish(core.TCPPort, port, host)
+		}
+	}
+	if len(ports) == 0 {
+		return
+	}
+
+	// The host is resolved once, so that DNS failures don't show up as
+	// filtered ports and ports aren't scanned on different addresses.
+	addr, err := ps.resolve(host)
+	if err != nil {
+		ps.session.Out.Debug("[%s] Error: %v\n", ps.ID(), err)
+		ps.session.Out.Error("Failed to resolve %s, skipping port scan\n", host)
+		return
+	}
+	ps.session.Stats.QueueTasks(core.StagePorts, len(ports))
+
+	state := ps.hostState(host)
+	for i, port := range ports {
+		if ps.session.Stopped() {
+			ps.session.Stats.DropTasks(core.StagePorts, len(ports)-i)
+			return
+		}
 		ps.session.WaitGroup.Add()
 		go func(port int, host string) {
 			defer ps.session.WaitGroup.Done()
-			if ps.scanPort(port, host) {
+			if !ps.acquire(state) {
+				ps.session.Stats.DropTasks(core.StagePorts, 1)
+				return
+			}
+			defer ps.release(state)
+			ps.session.Stats.StartTask(core.StagePorts)
+			defer ps.session.Stats.FinishTask(core.StagePorts)
+
+			portState := ps.scanPort(port, host, addr, state)
+			if portState == "" {
+				ps.session.Out.Debug("[%s] Scan of port %d on %s was cancelled\n", ps.ID(), port, host)
+				return
+			}
+			ps.session.SetPortState(host, port, portState)
+			switch portState {
+			case core.PortOpen:
 				ps.session.Stats.IncrementPortOpen()
 				ps.session.Out.Info(
 					"%s: port %s %s\n",
@@ -46,19 +164,104 @@ func (ps *TCPPortScanner) OnHost(host string) {
 					ps.session.Out.Green("open"),
 				)
 				ps.session.EventBus.Publish(core.TCPPort, port, host)
-			} else {
+			case core.PortClosed:
 				ps.session.Stats.IncrementPortClosed()
 				ps.session.Out.Debug("[%s] Port %d is closed on %s\n", ps.ID(), port, host)
+			case core.PortUnreachable:
+				ps.session.Stats.IncrementPortUnreachable()
+				ps.session.Out.Debug("[%s] Port %d is unreachable on %s\n", ps.ID(), port, host)
+			default:
+				ps.session.Stats.IncrementPortFiltered()
+				ps.session.Out.Debug("[%s] Port %d is filtered on %s\n", ps.ID(), port, host)
 			}
 		}(port, host)
 	}
 }
 
-func (ps *TCPPortScanner) scanPort(port int, host string) bool {
-	conn, _ := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", host, port), time.Duration(*ps.session.Options.ScanTimeout)*time.Millisecond)
-	if conn != nil {
-		_ = conn.Close()
+func (ps *TCPPortScanner) hostState(host string) *hostScanState {
+	host = strings.ToLower(host)
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	state, ok := ps.hosts[host]
+	if !ok {
+		state = &hostScanState{}
+		if n := *ps.session.Options.HostConnections; n > 0 {
+			state.slots = make(chan struct{}, n)
+		}
+		ps.hosts[host] = state
+	}
+	return state
+}
+
+// acquire waits for a free connection slot of the host. It returns false if
+// the scan was stopped meanwhile.
+func (ps *TCPPortScanner) acquire(state *hostScanState) bool {
+	if state.slots == nil {
+		return !ps.session.Stopped()
+	}
+	select {
+	case state.slots <- struct{}{}:
 		return true
+	case <-ps.session.Context().Done():
+		return false
+	}
+}
+
+func (ps *TCPPortScanner) release(state *hostScanState) {
+	if state.slots != nil {
+		<-state.slots
 	}
-	return false
+}
+
+// resolve returns the address host is scanned on, which is the host itself
+// if it is an IP address.
+func (ps *TCPPortScanner) resolve(host string) (string, error) {
+	if net.ParseIP(host) != nil {
+		return host, nil
+	}
+	addrs, err := net.DefaultResolver.LookupHost(ps.session.Context(), host)
+	if err != nil {
+		return "", err
+	}
+	return addrs[0], nil
+}
+
+// scanPort returns the state of port on host, connecting to addr, or an empty
+// string if the scan was stopped. Ports that time out are tried again with the
+// full timeout in case the shortened one was too short.
+func (ps *TCPPortScanner) scanPort(port int, host string, addr string, state *hostScanState) core.PortState {
+	maxTimeout := time.Duration(*ps.session.Options.ScanTimeout) * time.Millisecond
+	for _, timeout := range []time.Duration{state.timeout(maxTimeout), maxTimeout} {
+		if ps.session.Stopped() {
+			return ""
+		}
+		ps.session.Throttle(host)
+		start := time.Now()
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(addr, strconv.Itoa(port)), timeout)
+		if conn != nil {
+			_ = conn.Close()
+			state.addSample(time.Since(start))
+			return core.PortOpen
+		}
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			state.addSample(time.Since(start))
+			return core.PortClosed
+		}
+		if isUnreachable(err) {
+			ps.session.Out.Debug("[%s] Error: %v\n", ps.ID(), err)
+			return core.PortUnreachable
+		}
+		var netErr net.Error
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			ps.session.Out.Debug("[%s] Error: %v\n", ps.ID(), err)
+			return core.PortFiltered
+		}
+	}
+	return core.PortFiltered
+}
+
+// isUnreachable reports whether err means that there is no route to the host
+// or its network, as opposed to a firewall dropping or rejecting connections.
+func isUnreachable(err error) bool {
+	return errors.Is(err, syscall.EHOSTUNREACH) || errors.Is(err, syscall.ENETUNREACH)
 }
@@ -0,0 +1,194 @@
+package agents
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+func TestHostScanStateTimeout(t *testing.T) {
+	ms := time.Millisecond
+	tests := []struct {
+		name    string
+		samples []time.Duration
+		max     time.Duration
+		want    time.Duration
+	}{
+		{"no samples", nil, 2000 * ms, 2000 * ms},
+		{"first sample", []time.Duration{100 * ms}, 2000 * ms, 300 * ms},
+		{"smoothed", []time.Duration{200 * ms, 100 * ms}, 2000 * ms, 587500 * time.Microsecond},
+		{"steady", []time.Duration{100 * ms, 100 * ms, 100 * ms}, 2000 * ms, 212500 * time.Microsecond},
+		{"clamped to minimum", []time.Duration{5 * ms}, 2000 * ms, minScanTimeout},
+		{"clamped to maximum", []time.Duration{400 * ms}, 1000 * ms, 1000 * ms},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &hostScanState{}
+			for _, rtt := range tt.samples {
+				state.addSample(rtt)
+			}
+			if got := state.timeout(tt.max); got != tt.want {
+				t.Errorf("timeout(%s) = %s, want %s", tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanPort(t *testing.T) {
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	scanTimeout := 1000
+	options.OutDir = &outDir
+	options.ScanTimeout = &scanTimeout
+	s, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.SetContext(ctx)
+	ps := NewTCPPortScanner()
+	ps.session = s
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	openPort := listener.Addr().(*net.TCPAddr).Port
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	state := &hostScanState{}
+	if got := ps.scanPort(openPort, "localhost", "127.0.0.1", state); got != core.PortOpen {
+		t.Errorf("scanPort() of listening port = %q, want %q", got, core.PortOpen)
+	}
+	if got := ps.scanPort(closedPort, "localhost", "127.0.0.1", state); got != core.PortClosed {
+		t.Errorf("scanPort() of closed port = %q, want %q", got, core.PortClosed)
+	}
+	if state.samples != 2 {
+		t.Errorf("got %d round-trip samples, want 2", state.samples)
+	}
+
+	cancel()
+	if got := ps.scanPort(openPort, "localhost", "127.0.0.1", state); got != "" {
+		t.Errorf("scanPort() after stop = %q, want no state", got)
+	}
+}
+
+func TestIsUnreachable(t *testing.T) {
+	dialError := func(errno syscall.Errno) error {
+		return &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", errno)}
+	}
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{dialError(syscall.EHOSTUNREACH), true},
+		{dialError(syscall.ENETUNREACH), true},
+		{dialError(syscall.ECONNREFUSED), false},
+		{&net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}, false},
+		{os.ErrDeadlineExceeded, false},
+	}
+	for _, tt := range tests {
+		if got := isUnreachable(tt.err); got != tt.want {
+			t.Errorf("isUnreachable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestTCPPortScannerResolve(t *testing.T) {
+	ps := NewTCPPortScanner()
+	ps.session = &core.Session{}
+	ps.session.SetContext(context.Background())
+
+	for _, host := range []string{"192.0.2.1", "2001:db8::1"} {
+		if addr, err := ps.resolve(host); err != nil || addr != host {
+			t.Errorf("resolve(%s) = %s, %v", host, addr, err)
+		}
+	}
+	if addr, err := ps.resolve("localhost"); err != nil || net.ParseIP(addr) == nil || !net.ParseIP(addr).IsLoopback() {
+		t.Errorf("resolve(localhost) = %s, %v", addr, err)
+	}
+	if addr, err := ps.resolve("host.invalid"); err == nil {
+		t.Errorf("resolve() of an invalid name returned %s", addr)
+	}
+}
+
+func TestTCPPortScannerResumedPorts(t *testing.T) {
+	listening, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listening.Close()
+	recordedClosed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recordedClosed.Close()
+	unused, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordedOpen := unused.Addr().(*net.TCPAddr).Port
+	unused.Close()
+
+	openPort := listening.Addr().(*net.TCPAddr).Port
+	closedPort := recordedClosed.Addr().(*net.TCPAddr).Port
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	ports := fmt.Sprintf("%d,%d,%d", openPort, closedPort, recordedOpen)
+	silent := true
+	options.OutDir = &outDir
+	options.Ports = &ports
+	options.Silent = &silent
+	s, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// States saved by an interrupted scan are trusted even though the
+	// ports changed since.
+	s.SetPortState("127.0.0.1", closedPort, core.PortClosed)
+	s.SetPortState("127.0.0.1", recordedOpen, core.PortOpen)
+
+	var lock sync.Mutex
+	var published []int
+	s.EventBus.Subscribe(core.TCPPort, func(port int, host string) {
+		lock.Lock()
+		defer lock.Unlock()
+		published = append(published, port)
+	})
+
+	ps := NewTCPPortScanner()
+	ps.session = s
+	ps.OnHost("127.0.0.1")
+	s.WaitGroup.Wait()
+
+	sort.Ints(published)
+	want := []int{openPort, recordedOpen}
+	sort.Ints(want)
+	if !reflect.DeepEqual(published, want) {
+		t.Errorf("published open ports %v, want %v", published, want)
+	}
+	if got := s.PortState("127.0.0.1", openPort); got != core.PortOpen {
+		t.Errorf("state of scanned port = %q, want %q", got, core.PortOpen)
+	}
+	if s.Stats.PortOpen != 1 {
+		t.Errorf("counted %d open ports, want only the scanned one", s.Stats.PortOpen)
+	}
+}
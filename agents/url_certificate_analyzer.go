@@ -0,0 +1,169 @@
+package agents
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+type URLCertificateAnalyzer struct {
+	session   *core.Session
+	seenHosts map[string]struct{}
+	lock      sync.Mutex
+}
+
+func NewURLCertificateAnalyzer() *URLCertificateAnalyzer {
+	return &URLCertificateAnalyzer{
+		seenHosts: make(map[string]struct{}),
+	}
+}
+
+func (ca *URLCertificateAnalyzer) ID() string {
+	return "agent:url_certificate_analyzer"
+}
+
+func (ca *URLCertificateAnalyzer) Register(s *core.Session) error {
+	err := s.SubscribePageHandler(ca.OnURLResponsive)
+	if err != nil {
+		return err
+	}
+
+	ca.session = s
+
+	return nil
+}
+
+func (ca *URLCertificateAnalyzer) OnURLResponsive(url string) {
+	ca.session.Out.Debug("[%s] Received new responsive URL %s\n", ca.ID(), url)
+	page := ca.session.GetPage(url)
+	if page == nil {
+		ca.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	if ca.session.Stopped() || page.ParsedURL().Scheme != "https" {
+		ca.session.PageTaskDone(page)
+		return
+	}
+
+	ca.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer ca.session.WaitGroup.Done()
+		defer ca.session.PageTaskDone(page)
+		ca.analyzePage(page)
+	}(page)
+}
+
+func (ca *URLCertificateAnalyzer) analyzePage(page *core.Page) {
+	u := page.ParsedURL()
+	hostname := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	ca.session.Throttle(hostname)
+	dialer := &net.Dialer{Timeout: time.Duration(*ca.session.Options.HTTPTimeout) * time.Millisecond}
+	conf := &tls.Config{
+		InsecureSkipVerify: true,
+	}
+	if net.ParseIP(hostname) == nil {
+		conf.ServerName = hostname
+	}
+
+	addr := ca.session.VirtualHostAddr(net.JoinHostPort(hostname, port))
+	conn, err := tls.DialWithDialer(dialer, "tcp", addr, conf)
+	if err != nil {
+		ca.session.Out.Debug("[%s] Error: %v\n", ca.ID(), err)
+		return
+	}
+	state := conn.ConnectionState()
+	_ = conn.Close()
+
+	if len(state.PeerCertificates) == 0 {
+		return
+	}
+
+	page.SetTLS(core.NewTLSInfo(state))
+	leaf := state.PeerCertificates[0]
+
+	now := time.Now()
+	if now.After(leaf.NotAfter) {
+		page.AddTag("Expired Certificate", "danger", "")
+		ca.session.Out.Warn("%s: certificate expired at %s\n", page.URL, leaf.NotAfter.Format(time.RFC3339))
+	} else if now.Before(leaf.NotBefore) {
+		page.AddTag("Certificate Not Yet Valid", "warning", "")
+	}
+
+	if ca.isSelfSigned(leaf) {
+		page.AddTag("Self-Signed Certificate", "warning", "")
+	}
+
+	if err := leaf.VerifyHostname(hostname); err != nil {
+		page.AddTag("Certificate Hostname Mismatch", "warning", "")
+	}
+
+	if ca.isWeak(leaf) {
+		page.AddTag("Weak Certificate", "warning", "")
+	}
+
+	if *ca.session.Options.PublishCertHosts {
+		ca.publishHosts(hostname, leaf.DNSNames)
+	}
+}
+
+func (ca *URLCertificateAnalyzer) isSelfSigned(cert *x509.Certificate) bool {
+	if cert.Subject.String() != cert.Issuer.String() {
+		return false
+	}
+	return cert.CheckSignatureFrom(cert) == nil
+}
+
+func (ca *URLCertificateAnalyzer) isWeak(cert *x509.Certificate) bool {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < 2048 {
+			return true
+		}
+	case *ecdsa.PublicKey:
+		if key.Curve.Params().BitSize < 256 {
+			return true
+		}
+	}
+
+	switch cert.SignatureAlgorithm {
+	case x509.MD2WithRSA, x509.MD5WithRSA, x509.SHA1WithRSA, x509.DSAWithSHA1, x509.ECDSAWithSHA1:
+		return true
+	}
+
+	return false
+}
+
+// publishHosts feeds hostnames from the certificate's SANs back into the
+// pipeline. Wildcard names can't be requested and are skipped.
+func (ca *URLCertificateAnalyzer) publishHosts(hostname string, names []string) {
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(name, "."))
+		if strings.Contains(name, "*") || strings.EqualFold(name, hostname) {
+			continue
+		}
+
+		ca.lock.Lock()
+		_, seen := ca.seenHosts[name]
+		ca.seenHosts[name] = struct{}{}
+		ca.lock.Unlock()
+		if seen {
+			continue
+		}
+
+		ca.session.Out.Info("%s: discovered from certificate of %s\n", name, hostname)
+		ca.session.EventBus.Publish(core.Host, name)
+	}
+}
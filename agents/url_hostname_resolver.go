@@ -20,7 +20,7 @@ func (hr *URLHostnameResolver) ID() string {
 }
 
 func (hr *URLHostnameResolver) Register(s *core.Session) error {
-	err := s.EventBus.SubscribeAsync(core.URLResponsive, hr.OnURLResponsive, false)
+	err := s.SubscribePageHandler(hr.OnURLResponsive)
 	if err != nil {
 		return err
 	}
@@ -38,15 +38,24 @@ func (hr *URLHostnameResolver) OnURLResponsive(url string) {
 		return
 	}
 
+	if addr := hr.session.VirtualHostOf(page.URL); addr != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		page.SetAddrs([]string{host})
+		hr.session.PageTaskDone(page)
+		return
+	}
+
 	if page.IsIPHost() {
 		hr.session.Out.Debug("[%s] Skipping hostname resolving on IP host: %s\n", hr.ID(), url)
-		page.Addrs = []string{page.ParsedURL().Hostname()}
+		page.SetAddrs([]string{page.ParsedURL().Hostname()})
+		hr.session.PageTaskDone(page)
 		return
 	}
 
 	hr.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer hr.session.WaitGroup.Done()
+		defer hr.session.PageTaskDone(page)
 		addrs, err := net.LookupHost(fmt.Sprintf("%s.", page.ParsedURL().Hostname()))
 		if err != nil {
 			hr.session.Out.Debug("[%s] Error: %v\n", hr.ID(), err)
@@ -54,6 +63,6 @@ func (hr *URLHostnameResolver) OnURLResponsive(url string) {
 			return
 		}
 
-		page.Addrs = addrs
+		page.SetAddrs(addrs)
 	}(page)
 }
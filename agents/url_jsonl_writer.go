@@ -0,0 +1,101 @@
+package agents
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+type URLJSONLWriter struct {
+	session *core.Session
+	file    *os.File
+	lock    sync.Mutex
+}
+
+func NewURLJSONLWriter() *URLJSONLWriter {
+	return &URLJSONLWriter{}
+}
+
+func (jw *URLJSONLWriter) ID() string {
+	return "agent:url_jsonl_writer"
+}
+
+func (jw *URLJSONLWriter) Register(s *core.Session) error {
+	jw.session = s
+	if *s.Options.OutputJSONL == "" {
+		return nil
+	}
+
+	filePath := *s.Options.OutputJSONL
+	if !filepath.IsAbs(filePath) {
+		filePath = s.GetFilePath(filePath)
+	}
+	f, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	jw.file = f
+
+	// The file is rewritten with the pages kept from a resumed session
+	// instead of appended to, since the pages it dropped are processed
+	// again.
+	if err := jw.writeResumedPages(); err != nil {
+		return err
+	}
+
+	return s.EventBus.SubscribeAsync(core.URLProcessed, jw.OnURLProcessed, false)
+}
+
+func (jw *URLJSONLWriter) writeResumedPages() error {
+	jw.session.Lock()
+	urls := make([]string, 0, len(jw.session.Pages))
+	for url := range jw.session.Pages {
+		urls = append(urls, url)
+	}
+	jw.session.Unlock()
+	sort.Strings(urls)
+
+	for _, url := range urls {
+		line, err := jw.session.GetPage(url).ToJSON()
+		if err != nil {
+			return err
+		}
+		if _, err := jw.file.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (jw *URLJSONLWriter) OnURLProcessed(url string) {
+	jw.session.Out.Debug("[%s] Received processed URL %s\n", jw.ID(), url)
+	page := jw.session.GetPage(url)
+	if page == nil {
+		jw.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	line, err := page.ToJSON()
+	if err != nil {
+		jw.session.Out.Error("Unable to encode page %s as JSON: %s\n", url, err)
+		return
+	}
+
+	jw.lock.Lock()
+	defer jw.lock.Unlock()
+	if _, err := jw.file.Write(append(line, '\n')); err != nil {
+		jw.session.Out.Error("Unable to write JSON line for %s: %s\n", url, err)
+	}
+}
+
+func (jw *URLJSONLWriter) OnSessionEnd() {
+	jw.session.Out.Debug("[%s] Received SessionEnd event\n", jw.ID())
+	jw.lock.Lock()
+	defer jw.lock.Unlock()
+	if jw.file != nil {
+		_ = jw.file.Close()
+	}
+}
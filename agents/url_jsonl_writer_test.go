@@ -0,0 +1,59 @@
+package agents
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+func TestURLJSONLWriterResume(t *testing.T) {
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	jsonl := "pages.jsonl"
+	resume := true
+	options.OutDir = &outDir
+	options.OutputJSONL = &jsonl
+	options.Resume = &resume
+	s, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Lines written before the interruption, including one for a page that
+	// Resume dropped and that is processed again.
+	stale := `{"url":"http://b.example.com/"}` + "\n" + `{"url":"http://dropped.example.com/"}` + "\n"
+	if err := os.WriteFile(filepath.Join(outDir, jsonl), []byte(stale), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{"http://b.example.com/", "http://a.example.com/"} {
+		if _, err := s.AddPage(url); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	jw := NewURLJSONLWriter()
+	if err := jw.Register(s); err != nil {
+		t.Fatal(err)
+	}
+	jw.OnSessionEnd()
+
+	content, err := os.ReadFile(filepath.Join(outDir, jsonl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var urls []string
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		var page core.Page
+		if err := json.Unmarshal([]byte(line), &page); err != nil {
+			t.Fatalf("invalid line %q: %s", line, err)
+		}
+		urls = append(urls, page.URL)
+	}
+	if got := strings.Join(urls, " "); got != "http://a.example.com/ http://b.example.com/" {
+		t.Errorf("file holds pages %s, want the kept pages once", got)
+	}
+}
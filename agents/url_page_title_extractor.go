@@ -22,7 +22,7 @@ func (pe *URLPageTitleExtractor) ID() string {
 }
 
 func (pe *URLPageTitleExtractor) Register(s *core.Session) error {
-	err := s.EventBus.SubscribeAsync(core.URLResponsive, pe.OnURLResponsive, false)
+	err := s.SubscribePageHandler(pe.OnURLResponsive)
 	if err != nil {
 		return err
 	}
@@ -43,6 +43,7 @@ func (pe *URLPageTitleExtractor) OnURLResponsive(url string) {
 	pe.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer pe.session.WaitGroup.Done()
+		defer pe.session.PageTaskDone(page)
 		body, err := pe.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
 		if err != nil {
 			pe.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", pe.ID(), page.URL, err)
@@ -55,6 +56,6 @@ func (pe *URLPageTitleExtractor) OnURLResponsive(url string) {
 			return
 		}
 
-		page.PageTitle = strings.TrimSpace(doc.Find("Title").Text())
+		page.SetPageTitle(strings.TrimSpace(doc.Find("Title").Text()))
 	}(page)
 }
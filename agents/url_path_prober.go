@@ -0,0 +1,208 @@
+package agents
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/parnurzeal/gorequest"
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// maxProbeTokens limits how much of a response body is compared with the
+// baseline, since the cost of the comparison grows quadratically.
+const maxProbeTokens = 5000
+
+type probeResponse struct {
+	status     int
+	statusText string
+	location   string
+	tokens     []string
+}
+
+// URLPathProber requests the paths of the -paths wordlist on every
+// responsive base URL. Responses that look like the response to a random
+// path, which is how servers answering every path with a custom error page
+// are detected, are dropped. GET hits are processed as new URLs, hits with
+// other methods are noted on the base page.
+type URLPathProber struct {
+	session *core.Session
+	paths   []string
+	methods []string
+}
+
+func NewURLPathProber() *URLPathProber {
+	return &URLPathProber{}
+}
+
+func (pp *URLPathProber) ID() string {
+	return "agent:url_path_prober"
+}
+
+func (pp *URLPathProber) Register(s *core.Session) error {
+	pp.session = s
+	if *s.Options.Paths == "" {
+		return nil
+	}
+
+	if err := pp.loadPaths(); err != nil {
+		return err
+	}
+	for _, method := range strings.Split(*s.Options.PathMethods, ",") {
+		if method = strings.ToUpper(strings.TrimSpace(method)); method != "" {
+			pp.methods = append(pp.methods, method)
+		}
+	}
+
+	return s.SubscribePageHandler(pp.OnURLResponsive)
+}
+
+func (pp *URLPathProber) loadPaths() error {
+	f, err := os.Open(*pp.session.Options.Paths)
+	if err != nil {
+		return fmt.Errorf("Unable to read path wordlist %s: %s", *pp.session.Options.Paths, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		path := strings.TrimSpace(scanner.Text())
+		if path == "" || strings.HasPrefix(path, "#") {
+			continue
+		}
+		pp.paths = append(pp.paths, "/"+strings.TrimLeft(path, "/"))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Unable to read path wordlist %s: %s", *pp.session.Options.Paths, err)
+	}
+
+	pp.session.Out.Debug("[%s] Loaded %d paths\n", pp.ID(), len(pp.paths))
+	return nil
+}
+
+func (pp *URLPathProber) OnURLResponsive(url string) {
+	pp.session.Out.Debug("[%s] Received new responsive URL %s\n", pp.ID(), url)
+	page := pp.session.GetPage(url)
+	if page == nil {
+		pp.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	if pp.session.Stopped() || !isBaseURL(page.URL) {
+		pp.session.PageTaskDone(page)
+		return
+	}
+
+	pp.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer pp.session.WaitGroup.Done()
+		defer pp.session.PageTaskDone(page)
+		for _, method := range pp.methods {
+			pp.probe(page, method)
+		}
+	}(page)
+}
+
+func (pp *URLPathProber) probe(page *core.Page, method string) {
+	base := strings.TrimRight(page.URL, "/")
+	randomPath := fmt.Sprintf("/%016x", rand.Uint64())
+	baseline, err := probeRequest(pp.session, method, base+randomPath, "")
+	if err != nil {
+		pp.session.Out.Debug("[%s] Unable to get %s baseline for %s: %v\n", pp.ID(), method, page.URL, err)
+		return
+	}
+	baseline.location = strings.Replace(baseline.location, randomPath, "", -1)
+
+	for _, path := range pp.paths {
+		if pp.session.Stopped() {
+			return
+		}
+		probeURL := base + path
+		if !pp.session.AllowsURL(probeURL) {
+			continue
+		}
+
+		resp, err := probeRequest(pp.session, method, probeURL, "")
+		if err != nil {
+			pp.session.Out.Debug("[%s] Error: %v\n", pp.ID(), err)
+			continue
+		}
+		resp.location = strings.Replace(resp.location, path, "", -1)
+		if !pp.isHit(resp, baseline) {
+			continue
+		}
+
+		pp.session.Out.Info("%s: %s %s\n", probeURL, method, pp.session.Out.Green(resp.statusText))
+		page.AddNote(fmt.Sprintf("%s %s: %s", method, path, resp.statusText), "info")
+		if method == "GET" && !pp.session.HasPage(probeURL) {
+			pp.session.EventBus.Publish(core.URL, probeURL)
+		}
+	}
+}
+
+// isHit reports whether resp differs from the response to a random path.
+func (pp *URLPathProber) isHit(resp *probeResponse, baseline *probeResponse) bool {
+	if resp.status == http.StatusNotFound {
+		return false
+	}
+	return !resp.matches(baseline, *pp.session.Options.Soft404Threshold)
+}
+
+// matches reports whether r has the same status as other and either
+// redirects to the same location or has a body at least threshold similar.
+func (r *probeResponse) matches(other *probeResponse, threshold float64) bool {
+	if r.status != other.status {
+		return false
+	}
+	if r.status >= 300 && r.status < 400 {
+		return r.location == other.location
+	}
+	return core.GetSimilarity(r.tokens, other.tokens) >= threshold
+}
+
+// probeRequest sends a request without following redirects. If addr is set,
+// the request connects to it instead of the host of probeURL.
+func probeRequest(s *core.Session, method string, probeURL string, addr string) (*probeResponse, error) {
+	s.Throttle(hostnameFromURL(probeURL))
+	req := Gorequest(s.Options).RedirectPolicy(func(req gorequest.Request, via []gorequest.Request) error {
+		return http.ErrUseLastResponse
+	})
+	if addr != "" {
+		req.Transport.Proxy = nil
+		dial := req.Transport.Dial
+		req.Transport.Dial = func(network string, _ string) (net.Conn, error) {
+			return dial(network, addr)
+		}
+	} else {
+		dialVirtualHosts(req, s, probeURL)
+	}
+	resp, body, errs := setRequestHeaders(req.CustomMethod(method, probeURL), s, probeURL).EndBytes()
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	tokens := strings.Fields(string(body))
+	if len(tokens) > maxProbeTokens {
+		tokens = tokens[:maxProbeTokens]
+	}
+	return &probeResponse{
+		status:     resp.StatusCode,
+		statusText: resp.Status,
+		location:   resp.Header.Get("Location"),
+		tokens:     tokens,
+	}, nil
+}
+
+// isBaseURL reports whether rawURL points to the root of a site.
+func isBaseURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return (u.Path == "" || u.Path == "/") && u.RawQuery == ""
+}
@@ -0,0 +1,92 @@
+package agents
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// notFoundPage is a custom error page that mentions the requested path.
+const notFoundPage = `<html>
+<head><title>Example Corp - Page not found</title></head>
+<body>
+<h1>Page not found</h1>
+<p>Sorry, the page %s does not exist. It may have been moved or deleted.</p>
+<p>Go back to the <a href="/">home page</a> or contact support.</p>
+</body>
+</html>`
+
+func TestIsHit(t *testing.T) {
+	threshold := 0.9
+	pp := &URLPathProber{session: &core.Session{Options: core.Options{Soft404Threshold: &threshold}}}
+
+	errorPage := strings.Fields(fmt.Sprintf(notFoundPage, "/0123456789abcdef"))
+	errorPageForPath := strings.Fields(fmt.Sprintf(notFoundPage, "/admin"))
+	adminPage := strings.Fields("<html><head><title>Admin</title></head><body><form><input name=user><input name=password></form></body></html>")
+
+	tests := []struct {
+		name     string
+		resp     *probeResponse
+		baseline *probeResponse
+		want     bool
+	}{
+		{
+			name:     "not found",
+			resp:     &probeResponse{status: 404},
+			baseline: &probeResponse{status: 200, tokens: errorPage},
+			want:     false,
+		},
+		{
+			name:     "different status",
+			resp:     &probeResponse{status: 403},
+			baseline: &probeResponse{status: 404},
+			want:     true,
+		},
+		{
+			name:     "soft 404",
+			resp:     &probeResponse{status: 200, tokens: errorPageForPath},
+			baseline: &probeResponse{status: 200, tokens: errorPage},
+			want:     false,
+		},
+		{
+			name:     "different body",
+			resp:     &probeResponse{status: 200, tokens: adminPage},
+			baseline: &probeResponse{status: 200, tokens: errorPage},
+			want:     true,
+		},
+		{
+			name:     "redirect to login like every path",
+			resp:     &probeResponse{status: 302, location: "/login"},
+			baseline: &probeResponse{status: 302, location: "/login"},
+			want:     false,
+		},
+		{
+			name:     "redirect to slash",
+			resp:     &probeResponse{status: 301, location: "/admin/"},
+			baseline: &probeResponse{status: 301, location: "/login"},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pp.isHit(tt.resp, tt.baseline); got != tt.want {
+				t.Errorf("isHit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBaseURL(t *testing.T) {
+	for _, rawURL := range []string{"http://example.com", "http://example.com/", "https://example.com:8443/"} {
+		if !isBaseURL(rawURL) {
+			t.Errorf("isBaseURL(%q) = false", rawURL)
+		}
+	}
+	for _, rawURL := range []string{"http://example.com/admin", "http://example.com/?page=1", "http://example.com/%zz"} {
+		if isBaseURL(rawURL) {
+			t.Errorf("isBaseURL(%q) = true", rawURL)
+		}
+	}
+}
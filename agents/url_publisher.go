@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"sdg-git.solar.local/golang/aquatone/core"
@@ -34,15 +35,47 @@ func (up *URLPublisher) Register(s *core.Session) error {
 
 func (up *URLPublisher) OnTCPPort(port int, host string) {
 	up.session.Out.Debug("[%s] Received new open port on %s: %d\n", up.ID(), host, port)
-	var url string
-	if up.isTLS(port, host) {
-		url = HostAndPortToURL(host, port, "https")
-	} else {
-		url = HostAndPortToURL(host, port, "http")
+	if up.session.Stopped() {
+		return
+	}
+	if up.processed(port, host) {
+		up.session.Out.Debug("[%s] Skipping port %d on host %s processed in resumed session\n", up.ID(), port, host)
+		return
+	}
+	if *up.session.Options.BannerTimeout == 0 {
+		var url string
+		if up.isTLS(port, host) {
+			url = HostAndPortToURL(host, port, "https")
+		} else {
+			url = HostAndPortToURL(host, port, "http")
+		}
+		up.session.EventBus.Publish(core.URL, url)
+		return
+	}
+
+	url, service := detectService(up.session, host, port)
+	if service != nil {
+		up.session.AddService(service)
+		description := up.session.Out.Yellow(service.Name)
+		if service.Banner != "" {
+			description += fmt.Sprintf(" (%s)", service.Banner)
+		}
+		up.session.Out.Info("%s: port %d is %s\n", host, port, description)
+		return
 	}
 	up.session.EventBus.Publish(core.URL, url)
 }
 
+// processed reports whether port on host was already identified as a service
+// or requested as a URL, which is the case for ports of a resumed session.
+func (up *URLPublisher) processed(port int, host string) bool {
+	if up.session.HasService(host, port) {
+		return true
+	}
+	return up.session.HasPage(HostAndPortToURL(host, port, "http")) ||
+		up.session.HasPage(HostAndPortToURL(host, port, "https"))
+}
+
 func (up *URLPublisher) isTLS(port int, host string) bool {
 	if port == 80 {
 		return false
@@ -52,12 +85,13 @@ func (up *URLPublisher) isTLS(port int, host string) bool {
 		return true
 	}
 
+	up.session.Throttle(host)
 	dialer := &net.Dialer{Timeout: time.Duration(*up.session.Options.HTTPTimeout) * time.Millisecond}
 	conf := &tls.Config{
 		InsecureSkipVerify: true,
 	}
 
-	conn, err := tls.DialWithDialer(dialer, "tcp", fmt.Sprintf("%s:%d", host, port), conf)
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, strconv.Itoa(port)), conf)
 	if err != nil {
 		return false
 	}
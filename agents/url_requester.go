@@ -3,6 +3,7 @@ package agents
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"sdg-git.solar.local/golang/aquatone/core"
 )
 
+const maxRedirects = 10
+
 type URLRequester struct {
 	session *core.Session
 }
@@ -37,16 +40,30 @@ func (ur *URLRequester) Register(s *core.Session) error {
 func (ur *URLRequester) OnURL(url string) {
 	ur.session.Out.Debug("[%s] Received new URL %s\n", ur.ID(), url)
 
+	if ur.session.HasPage(url) {
+		ur.session.Out.Debug("[%s] Skipping already processed URL %s\n", ur.ID(), url)
+		return
+	}
+
+	if !ur.session.InScopeURL(url) {
+		return
+	}
+
+	ur.session.Stats.QueueTasks(core.StageRequests, 1)
 	ur.session.WaitGroup.Add()
 	go func(url string) {
 		defer ur.session.WaitGroup.Done()
+		ur.session.Stats.StartTask(core.StageRequests)
+		defer ur.session.Stats.FinishTask(core.StageRequests)
+		if ur.session.Stopped() {
+			return
+		}
 
-		http := Gorequest(ur.session.Options)
-		resp, _, errs := http.Get(url).
-			Set("User-Agent", RandomUserAgent()).
-			Set("X-Forwarded-For", RandomIPv4Address()).
-			Set("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address())).
-			Set("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(), RandomIPv4Address())).End()
+		var redirects []gorequest.Response
+		http := Gorequest(ur.session.Options).RedirectPolicy(ur.redirectPolicy(&redirects))
+		dialVirtualHosts(http, ur.session, url)
+		ur.session.Throttle(hostnameFromURL(url))
+		resp, _, errs := setRequestHeaders(http.Get(url), ur.session, url).End()
 
 		if errs != nil {
 			ur.session.Stats.IncrementRequestFailed()
@@ -80,29 +97,44 @@ func (ur *URLRequester) OnURL(url string) {
 
 		ur.session.Out.Info("%s: %s\n", url, status)
 
-		page, err := ur.createPageFromResponse(url, resp)
+		page, err := ur.createPageFromResponse(url, resp, redirects)
 		if err != nil {
 			ur.session.Out.Debug("[%s] Error: %v\n", ur.ID(), err)
 			ur.session.Out.Error("Failed to create page for URL: %s\n", url)
 			return
 		}
 
+		if len(page.Redirects) > 0 {
+			ur.session.Out.Debug("[%s] %s redirected %d times to %s\n", ur.ID(), url, len(page.Redirects), page.FinalURL)
+			if *ur.session.Options.PublishRedirects && ur.leavesHost(url, page.FinalURL) {
+				ur.session.Out.Info("%s: redirects to %s\n", url, page.FinalURL)
+				ur.session.EventBus.Publish(core.URL, page.FinalURL)
+			}
+		}
+
 		ur.writeHeaders(page)
 		if *ur.session.Options.SaveBody {
 			ur.writeBody(page, resp)
 		}
 
-		ur.session.EventBus.Publish(core.URLResponsive, url)
+		ur.session.PublishURLResponsive(page)
 	}(url)
 }
 
-func (ur *URLRequester) createPageFromResponse(url string, resp gorequest.Response) (*core.Page, error) {
+func (ur *URLRequester) createPageFromResponse(url string, resp gorequest.Response, redirects []gorequest.Response) (*core.Page, error) {
 	page, err := ur.session.AddPage(url)
 	if err != nil {
 		return nil, err
 	}
 
-	page.Status = resp.Status
+	page.SetResponse(resp.Status, resp.Request.URL.String())
+	if addr := ur.session.VirtualHostOf(url); addr != "" {
+		page.SetVirtualHostOf(addr)
+		page.AddTag("Virtual Host", "info", "")
+	}
+	for _, redirect := range redirects {
+		page.AddRedirect(redirect.Request.URL.String(), redirect.Status, redirect.Header.Get("Location"), redirect.Header)
+	}
 	for name, value := range resp.Header {
 		page.AddHeader(name, strings.Join(value, " "))
 	}
@@ -110,6 +142,32 @@ func (ur *URLRequester) createPageFromResponse(url string, resp gorequest.Respon
 	return page, nil
 }
 
+// redirectPolicy follows up to maxRedirects redirects and appends the
+// response of every redirect it follows to redirects.
+func (ur *URLRequester) redirectPolicy(redirects *[]gorequest.Response) func(req gorequest.Request, via []gorequest.Request) error {
+	return func(req gorequest.Request, via []gorequest.Request) error {
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		if !ur.session.InScopeURL(req.URL.String()) {
+			return http.ErrUseLastResponse
+		}
+		ur.session.Throttle(req.URL.Hostname())
+		if req.Response != nil {
+			*redirects = append(*redirects, req.Response)
+		}
+		return nil
+	}
+}
+
+// leavesHost reports whether finalURL points to a different host than url.
+func (ur *URLRequester) leavesHost(url string, finalURL string) bool {
+	if !hasHTTPScheme(finalURL) {
+		return false
+	}
+	return !strings.EqualFold(hostnameFromURL(url), hostnameFromURL(finalURL))
+}
+
 func (ur *URLRequester) writeHeaders(page *core.Page) {
 	filepath := fmt.Sprintf("headers/%s.txt", page.BaseFilename())
 	headers := fmt.Sprintf("%s\n", page.Status)
@@ -127,7 +185,7 @@ func (ur *URLRequester) writeHeaders(page *core.Page) {
 		ur.session.Out.Error("Failed to write HTTP response headers for %s to %s\n", page.URL, ur.session.GetFilePath(filepath))
 	}
 
-	page.HeadersPath = filepath
+	page.SetHeadersPath(filepath)
 }
 
 func (ur *URLRequester) writeBody(page *core.Page, resp gorequest.Response) {
@@ -148,5 +206,5 @@ func (ur *URLRequester) writeBody(page *core.Page, resp gorequest.Response) {
 		ur.session.Out.Error("Failed to write HTTP response body for %s to %s\n", page.URL, ur.session.GetFilePath(filepath))
 	}
 
-	page.BodyPath = filepath
+	page.SetBodyPath(filepath)
 }
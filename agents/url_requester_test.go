@@ -0,0 +1,111 @@
+package agents
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/parnurzeal/gorequest"
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// countdownServer redirects /n to /n-1 until it reaches /0.
+func countdownServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/"))
+		if n > 0 {
+			http.Redirect(w, r, fmt.Sprintf("/%d", n-1), http.StatusFound)
+			return
+		}
+		fmt.Fprint(w, "done")
+	}))
+}
+
+func testRequestOptions() core.Options {
+	proxy := ""
+	timeout := 5000
+	debug := false
+	jitter := 0
+	return core.Options{Proxy: &proxy, HTTPTimeout: &timeout, Debug: &debug, Jitter: &jitter}
+}
+
+func TestRedirectPolicy(t *testing.T) {
+	server := countdownServer()
+	defer server.Close()
+
+	tests := []struct {
+		start     int
+		redirects int
+		fails     bool
+	}{
+		{0, 0, false},
+		{3, 3, false},
+		{9, 9, false},
+		{10, 9, true},
+	}
+	for _, tt := range tests {
+		ur := &URLRequester{session: &core.Session{Options: testRequestOptions()}}
+		var redirects []gorequest.Response
+		resp, _, errs := Gorequest(testRequestOptions()).
+			RedirectPolicy(ur.redirectPolicy(&redirects)).
+			Get(fmt.Sprintf("%s/%d", server.URL, tt.start)).
+			End()
+
+		if tt.fails != (errs != nil) {
+			t.Errorf("/%d: errors = %v, want failure %v", tt.start, errs, tt.fails)
+			continue
+		}
+		if len(redirects) != tt.redirects {
+			t.Errorf("/%d: recorded %d redirects, want %d", tt.start, len(redirects), tt.redirects)
+		}
+		for i, redirect := range redirects {
+			from := fmt.Sprintf("/%d", tt.start-i)
+			if redirect.Request.URL.Path != from || redirect.StatusCode != http.StatusFound {
+				t.Errorf("/%d: redirect %d is %d from %s, want 302 from %s", tt.start, i, redirect.StatusCode, redirect.Request.URL.Path, from)
+			}
+		}
+		if !tt.fails && resp.Request.URL.Path != "/0" {
+			t.Errorf("/%d: ended at %s, want /0", tt.start, resp.Request.URL.Path)
+		}
+	}
+}
+
+func TestRedirectPolicyScope(t *testing.T) {
+	server := countdownServer()
+	defer server.Close()
+
+	scope, err := core.ParseScope(strings.NewReader("127.0.0.1\n!re:/2$\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ur := &URLRequester{session: &core.Session{
+		Options: testRequestOptions(),
+		Scope:   scope,
+		Stats:   &core.Stats{},
+		Out:     core.NewLogger(io.Discard, false, true, true),
+	}}
+	var redirects []gorequest.Response
+	resp, _, errs := Gorequest(testRequestOptions()).
+		RedirectPolicy(ur.redirectPolicy(&redirects)).
+		Get(server.URL + "/5").
+		End()
+	if errs != nil {
+		t.Fatal(errs)
+	}
+
+	// The redirect to the excluded /2 isn't followed, and the redirect
+	// pointing to it is the final response.
+	if len(redirects) != 2 {
+		t.Errorf("recorded %d redirects, want 2", len(redirects))
+	}
+	if resp.Request.URL.Path != "/3" || resp.StatusCode != http.StatusFound {
+		t.Errorf("ended with %d at %s, want 302 at /3", resp.StatusCode, resp.Request.URL.Path)
+	}
+	if ur.session.Stats.OutOfScope != 1 {
+		t.Errorf("counted %d out of scope URLs, want 1", ur.session.Stats.OutOfScope)
+	}
+}
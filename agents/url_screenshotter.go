@@ -3,6 +3,7 @@ package agents
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
 	"regexp"
@@ -17,6 +18,12 @@ type URLScreenshotter struct {
 	session         *core.Session
 	chromePath      string
 	tempUserDirPath string
+	width           int
+	height          int
+	pool            *chromeTabPool
+	userAgent       string
+	headers         map[string]string
+	vhostProxy      *vhostProxy
 }
 
 func NewURLScreenshotter() *URLScreenshotter {
@@ -28,22 +35,37 @@ func (us *URLScreenshotter) ID() string {
 }
 
 func (us *URLScreenshotter) Register(s *core.Session) error {
-	err := s.EventBus.SubscribeAsync(core.URLResponsive, us.OnURLResponsive, false)
-	if err != nil {
+	us.session = s
+
+	if err := us.parseResolution(); err != nil {
 		return err
 	}
-
-	err = s.EventBus.SubscribeAsync(core.SessionEnd, us.OnSessionEnd, false)
-	if err != nil {
+	if err := us.locateChrome(); err != nil {
 		return err
 	}
+	if err := us.createTempUserDir(); err != nil {
+		return err
+	}
+	if *s.Options.VHosts != "" || *s.Options.VHostsFromSession {
+		vp, err := newVHostProxy(s)
+		if err != nil {
+			return err
+		}
+		us.vhostProxy = vp
+		s.Out.Debug("[%s] Started virtual host proxy at %s\n", us.ID(), vp.URL())
+	}
+	us.pool = newChromeTabPool(us.chromePath, us.chromeArguments(), *s.Options.Threads, 30*time.Second)
 
-	us.session = s
-
-	us.createTempUserDir()
-	us.locateChrome()
+	us.headers = make(map[string]string)
+	for name, values := range s.Headers {
+		if name == "User-Agent" {
+			us.userAgent = values[len(values)-1]
+			continue
+		}
+		us.headers[name] = strings.Join(values, ", ")
+	}
 
-	return nil
+	return s.SubscribePageHandler(us.OnURLResponsive)
 }
 
 func (us *URLScreenshotter) OnURLResponsive(url string) {
@@ -54,34 +76,55 @@ func (us *URLScreenshotter) OnURLResponsive(url string) {
 		return
 	}
 
+	if us.session.Stopped() || !us.session.InScopeURL(page.URL) {
+		us.session.PageTaskDone(page)
+		return
+	}
+
+	us.session.Stats.QueueTasks(core.StageScreenshots, 1)
 	us.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer us.session.WaitGroup.Done()
+		defer us.session.PageTaskDone(page)
+		us.session.Stats.StartTask(core.StageScreenshots)
+		defer us.session.Stats.FinishTask(core.StageScreenshots)
 		us.screenshotPage(page)
 	}(page)
 }
 
 func (us *URLScreenshotter) OnSessionEnd() {
 	us.session.Out.Debug("[%s] Received SessionEnd event\n", us.ID())
+	us.pool.Close()
+	us.session.Out.Debug("[%s] Closed browser\n", us.ID())
+	if us.vhostProxy != nil {
+		us.vhostProxy.Close()
+	}
 	_ = os.RemoveAll(us.tempUserDirPath)
 	us.session.Out.Debug("[%s] Deleted temporary user directory at: %s\n", us.ID(), us.tempUserDirPath)
 }
 
-func (us *URLScreenshotter) createTempUserDir() {
+// OnSessionAbort closes the browser and deletes the temporary user directory
+// when the scan is aborted without waiting for running screenshots.
+func (us *URLScreenshotter) OnSessionAbort() {
+	us.pool.Close()
+	_ = os.RemoveAll(us.tempUserDirPath)
+}
+
+func (us *URLScreenshotter) createTempUserDir() error {
 	dir, err := os.MkdirTemp("", "aquatone-chrome")
 	if err != nil {
-		us.session.Out.Fatal("Unable to create temporary user directory for Chrome/Chromium browser\n")
-		os.Exit(1)
+		return fmt.Errorf("Unable to create temporary user directory for Chrome/Chromium browser: %s", err)
 	}
 
 	us.session.Out.Debug("[%s] Created temporary user directory at: %s\n", us.ID(), dir)
 	us.tempUserDirPath = dir
+	return nil
 }
 
-func (us *URLScreenshotter) locateChrome() {
+func (us *URLScreenshotter) locateChrome() error {
 	if *us.session.Options.ChromePath != "" {
 		us.chromePath = *us.session.Options.ChromePath
-		return
+		return nil
 	}
 
 	paths := []string{
@@ -104,8 +147,7 @@ func (us *URLScreenshotter) locateChrome() {
 	}
 
 	if us.chromePath == "" {
-		us.session.Out.Fatal("Unable to locate a valid installation of Chrome. Install Google Chrome or try specifying a valid location with the -chrome-path option.\n")
-		os.Exit(1)
+		return fmt.Errorf("Unable to locate a valid installation of Chrome. Install Google Chrome or try specifying a valid location with the -chrome-path option")
 	}
 
 	if strings.Contains(strings.ToLower(us.chromePath), "chrome") {
@@ -114,14 +156,14 @@ func (us *URLScreenshotter) locateChrome() {
 		out, err := exec.Command(us.chromePath, "--version").Output()
 		if err != nil {
 			us.session.Out.Warn("An error occurred while trying to determine version of Chromium.\n\n")
-			return
+			return nil
 		}
 		version := string(out)
 		re := regexp.MustCompile(`(\d+)\.`)
 		match := re.FindStringSubmatch(version)
 		if len(match) <= 0 {
 			us.session.Out.Warn("Unable to determine version of Chromium. Screenshotting might be unreliable.\n\n")
-			return
+			return nil
 		}
 		majorVersion, _ := strconv.Atoi(match[1])
 		if majorVersion < 72 {
@@ -130,72 +172,136 @@ func (us *URLScreenshotter) locateChrome() {
 	}
 
 	us.session.Out.Debug("[%s] Located Chrome/Chromium binary at %s\n", us.ID(), us.chromePath)
+	return nil
 }
 
-func (us *URLScreenshotter) screenshotPage(page *core.Page) {
-	filePath := fmt.Sprintf("screenshots/%s.png", page.BaseFilename())
+func (us *URLScreenshotter) chromeArguments() []string {
 	var chromeArguments = []string{
 		"--headless", "--disable-gpu", "--hide-scrollbars", "--mute-audio", "--disable-notifications",
-		"--no-first-run", "--disable-crash-reporter", "--ignore-certificate-errors", "--incognito",
+		"--no-first-run", "--disable-crash-reporter", "--ignore-certificate-errors",
 		"--disable-infobars", "--disable-sync", "--no-default-browser-check",
 		"--user-data-dir=" + us.tempUserDirPath,
-		"--user-agent=" + RandomUserAgent(),
 		"--window-size=" + *us.session.Options.Resolution,
-		"--screenshot=" + us.session.GetFilePath(filePath),
 	}
 
 	if os.Geteuid() == 0 {
 		chromeArguments = append(chromeArguments, "--no-sandbox")
 	}
 
-	if *us.session.Options.Proxy != "" {
+	if us.vhostProxy != nil {
+		chromeArguments = append(chromeArguments, "--proxy-server="+us.vhostProxy.URL())
+	} else if *us.session.Options.Proxy != "" {
 		chromeArguments = append(chromeArguments, "--proxy-server="+*us.session.Options.Proxy)
 	}
 
-	chromeArguments = append(chromeArguments, page.URL)
+	return chromeArguments
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*us.session.Options.ScreenshotTimeout)*time.Millisecond)
-	defer cancel()
+func (us *URLScreenshotter) parseResolution() error {
+	parts := strings.Split(*us.session.Options.Resolution, ",")
+	if len(parts) == 2 {
+		width, errWidth := strconv.Atoi(strings.TrimSpace(parts[0]))
+		height, errHeight := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if errWidth == nil && errHeight == nil && width > 0 && height > 0 {
+			us.width = width
+			us.height = height
+			return nil
+		}
+	}
 
-	cmd := exec.CommandContext(ctx, us.chromePath, chromeArguments...)
-	defer us.killChromeProcessIfRunning(cmd)
+	return fmt.Errorf("Invalid screenshot resolution given: %s", *us.session.Options.Resolution)
+}
 
-	if err := cmd.Start(); err != nil {
-		us.session.Out.Debug("[%s] Error: %v\n", us.ID(), err)
+func (us *URLScreenshotter) screenshotPage(page *core.Page) {
+	extension := *us.session.Options.ScreenshotFormat
+	if extension == "jpeg" {
+		extension = "jpg"
+	}
+	filePath := fmt.Sprintf("screenshots/%s.%s", page.BaseFilename(), extension)
+	thumbnailPath := fmt.Sprintf("screenshots/%s_thumb.%s", page.BaseFilename(), extension)
+	timeout := time.Duration(*us.session.Options.ScreenshotTimeout) * time.Millisecond
+
+	us.session.Throttle(page.ParsedURL().Hostname())
+
+	// Waiting for a tab counts against -screenshot-timeout too, so that a
+	// screenshot never takes longer than that in total.
+	ctx, cancel := context.WithTimeout(us.session.Context(), timeout)
+	defer cancel()
+
+	tab, err := us.pool.Acquire(ctx)
+	if err != nil {
 		us.session.Stats.IncrementScreenshotFailed()
-		us.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
+		us.session.Out.Debug("[%s] Error: %v\n", us.ID(), err)
+		us.session.Out.Error("%s: screenshot failed: unable to get browser tab: %s\n", page.URL, err)
 		return
 	}
 
-	if err := cmd.Wait(); err != nil {
+	userAgent := us.userAgent
+	if userAgent == "" {
+		userAgent = RandomUserAgent()
+	}
+	var cookies []*http.Cookie
+	if us.session.Cookies != nil {
+		cookies = us.session.Cookies.Cookies
+	}
+	var authorization func(url string) string
+	if len(us.session.Credentials) > 0 {
+		authorization = us.session.Authorization
+	}
+
+	screenshot, thumbnail, err := tab.Screenshot(ctx, page.URL, screenshotOptions{
+		Width:          us.width,
+		Height:         us.height,
+		UserAgent:      userAgent,
+		Headers:        us.headers,
+		Cookies:        cookies,
+		Authorization:  authorization,
+		WaitFor:        *us.session.Options.ScreenshotWait,
+		Selector:       *us.session.Options.ScreenshotSelector,
+		Delay:          time.Duration(*us.session.Options.ScreenshotDelay) * time.Millisecond,
+		Format:         *us.session.Options.ScreenshotFormat,
+		Quality:        *us.session.Options.ScreenshotQuality,
+		FullPage:       *us.session.Options.FullPage,
+		Element:        *us.session.Options.ScreenshotElement,
+		ThumbnailWidth: *us.session.Options.ThumbnailWidth,
+	})
+	us.pool.Release(tab)
+	if err != nil {
 		us.session.Stats.IncrementScreenshotFailed()
 		us.session.Out.Debug("[%s] Error: %v\n", us.ID(), err)
 		if ctx.Err() == context.DeadlineExceeded {
 			us.session.Out.Error("%s: screenshot timed out\n", page.URL)
+		} else if ctx.Err() == context.Canceled {
+			us.session.Out.Warn("%s: screenshot cancelled\n", page.URL)
 		} else {
 			us.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
 		}
 		return
 	}
 
-	us.session.Stats.IncrementScreenshotSuccessful()
-	us.session.Out.Info("%s: %s\n", page.URL, us.session.Out.Green("screenshot successful"))
-	page.ScreenshotPath = filePath
-	page.HasScreenshot = true
-
-	saved := us.session.IsFileSaved(us.session.GetFilePath(filePath), time.Duration(*us.session.Options.ScreenshotTimeout)*time.Millisecond)
-	if !saved {
-		us.session.Out.Error("Error: file %q not saved\n", filePath)
+	if err := os.WriteFile(us.session.GetFilePath(filePath), screenshot, 0644); err != nil {
+		us.session.Stats.IncrementScreenshotFailed()
+		us.session.Out.Debug("[%s] Error: %v\n", us.ID(), err)
+		us.session.Out.Error("Failed to write screenshot for %s to %s\n", page.URL, us.session.GetFilePath(filePath))
+		return
 	}
 
-	return
-}
+	if thumbnail != nil {
+		if err := os.WriteFile(us.session.GetFilePath(thumbnailPath), thumbnail, 0644); err != nil {
+			us.session.Out.Debug("[%s] Error: %v\n", us.ID(), err)
+			us.session.Out.Error("Failed to write screenshot thumbnail for %s to %s\n", page.URL, us.session.GetFilePath(thumbnailPath))
+			thumbnailPath = ""
+		}
+	} else {
+		thumbnailPath = ""
+	}
 
-func (us *URLScreenshotter) killChromeProcessIfRunning(cmd *exec.Cmd) {
-	if cmd.Process == nil {
-		return
+	hash, err := core.NewImageHash(screenshot)
+	if err != nil {
+		us.session.Out.Debug("[%s] Unable to hash screenshot of %s: %v\n", us.ID(), page.URL, err)
 	}
 
-	_ = cmd.Process.Release()
-	_ = cmd.Process.Kill()
+	us.session.Stats.IncrementScreenshotSuccessful()
+	us.session.Out.Info("%s: %s\n", page.URL, us.session.Out.Green("screenshot successful"))
+	page.SetScreenshot(filePath, thumbnailPath, hash)
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
 	"regexp"
 
 	"github.com/PuerkitoBio/goquery"
@@ -91,34 +90,30 @@ func (uf *URLTechnologyFingerprinter) ID() string {
 }
 
 func (uf *URLTechnologyFingerprinter) Register(s *core.Session) error {
-	err := s.EventBus.SubscribeAsync(core.URLResponsive, uf.OnURLResponsive, false)
-	if err != nil {
-		return err
-	}
-
 	uf.session = s
 
-	uf.loadFingerprints()
+	if err := uf.loadFingerprints(); err != nil {
+		return err
+	}
 
-	return nil
+	return s.SubscribePageHandler(uf.OnURLResponsive)
 }
 
-func (uf *URLTechnologyFingerprinter) loadFingerprints() {
+func (uf *URLTechnologyFingerprinter) loadFingerprints() error {
 	fingerprints, err := uf.session.Asset("static/wappalyzer_fingerprints.json")
 	if err != nil {
-		uf.session.Out.Fatal("Can't read technology fingerprints file\n")
-		os.Exit(1)
+		return fmt.Errorf("Can't read technology fingerprints file: %s", err)
 	}
 
 	err = json.Unmarshal(fingerprints, &uf.fingerprints)
 	if err != nil {
-		uf.session.Out.Fatal("unmarshal fingerprints error: %v", err)
-		os.Exit(1)
+		return fmt.Errorf("unmarshal fingerprints error: %v", err)
 	}
 
 	for i := range uf.fingerprints {
 		uf.fingerprints[i].LoadPatterns()
 	}
+	return nil
 }
 
 func (uf *URLTechnologyFingerprinter) OnURLResponsive(url string) {
@@ -129,9 +124,13 @@ func (uf *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 		return
 	}
 
+	uf.session.Stats.QueueTasks(core.StageFingerprints, 1)
 	uf.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer uf.session.WaitGroup.Done()
+		defer uf.session.PageTaskDone(page)
+		uf.session.Stats.StartTask(core.StageFingerprints)
+		defer uf.session.Stats.FinishTask(core.StageFingerprints)
 		seen := make(map[string]struct{})
 		fingerprints := append(uf.fingerprintHeaders(page), uf.fingerprintBody(page)...)
 		for _, f := range fingerprints {
@@ -0,0 +1,239 @@
+package agents
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// vhostTarget is the base URL of an IP target that hostnames are tried on.
+// Requests to a target are made one at a time, so that responses of new
+// virtual hosts can be compared with the ones found before.
+type vhostTarget struct {
+	sync.Mutex
+	url       *url.URL
+	addr      string
+	baselines []*probeResponse
+	found     []*probeResponse
+	ready     bool
+}
+
+// URLVHostEnumerator requests the base URL of every IP target with the
+// hostnames of the -vhosts list, and those learned from scanned pages and
+// certificates with -vhosts-from-session, as Host header and SNI. Responses
+// that differ from the response for the IP address, for a random hostname
+// and for the virtual hosts already found on the target are processed as new
+// pages.
+type URLVHostEnumerator struct {
+	session *core.Session
+	lock    sync.Mutex
+	names   []string
+	seen    map[string]struct{}
+	targets []*vhostTarget
+}
+
+func NewURLVHostEnumerator() *URLVHostEnumerator {
+	return &URLVHostEnumerator{
+		seen: make(map[string]struct{}),
+	}
+}
+
+func (ve *URLVHostEnumerator) ID() string {
+	return "agent:url_vhost_enumerator"
+}
+
+func (ve *URLVHostEnumerator) Register(s *core.Session) error {
+	ve.session = s
+	if *s.Options.VHosts == "" && !*s.Options.VHostsFromSession {
+		return nil
+	}
+
+	if *s.Options.VHosts != "" {
+		if err := ve.loadNames(); err != nil {
+			return err
+		}
+	}
+
+	return s.EventBus.SubscribeAsync(core.URLProcessed, ve.OnURLProcessed, false)
+}
+
+func (ve *URLVHostEnumerator) loadNames() error {
+	f, err := os.Open(*ve.session.Options.VHosts)
+	if err != nil {
+		return fmt.Errorf("Unable to read virtual host list %s: %s", *ve.session.Options.VHosts, err)
+	}
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+		names = append(names, name)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Unable to read virtual host list %s: %s", *ve.session.Options.VHosts, err)
+	}
+
+	ve.addNames(names)
+	ve.session.Out.Debug("[%s] Loaded %d hostnames\n", ve.ID(), len(ve.names))
+	return nil
+}
+
+// addNames adds the valid hostnames of names that aren't known yet and
+// returns them. It must be called with the lock held or before the agent is
+// subscribed.
+func (ve *URLVHostEnumerator) addNames(names []string) []string {
+	var added []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(name, "."))
+		if name == "" || strings.Contains(name, "*") || net.ParseIP(name) != nil {
+			continue
+		}
+		if _, ok := ve.seen[name]; ok {
+			continue
+		}
+		ve.seen[name] = struct{}{}
+		ve.names = append(ve.names, name)
+		added = append(added, name)
+	}
+	return added
+}
+
+func (ve *URLVHostEnumerator) OnURLProcessed(url string) {
+	ve.session.Out.Debug("[%s] Received processed URL %s\n", ve.ID(), url)
+	page := ve.session.GetPage(url)
+	if page == nil || ve.session.Stopped() {
+		return
+	}
+
+	ve.lock.Lock()
+	var learned []string
+	if *ve.session.Options.VHostsFromSession {
+		learned = ve.addNames(ve.learnedNames(page))
+	}
+	targets := append([]*vhostTarget(nil), ve.targets...)
+	var target *vhostTarget
+	var names []string
+	if page.IsIPHost() && isBaseURL(page.URL) && page.VirtualHostOf == "" {
+		u := page.ParsedURL()
+		target = &vhostTarget{url: u, addr: core.URLAddr(u)}
+		ve.targets = append(ve.targets, target)
+		names = append([]string(nil), ve.names...)
+	}
+	ve.lock.Unlock()
+
+	if len(learned) > 0 {
+		for _, t := range targets {
+			ve.enumerate(t, learned)
+		}
+	}
+	if target != nil && len(names) > 0 {
+		ve.enumerate(target, names)
+	}
+}
+
+// learnedNames returns the hostname of page and the names in its certificate.
+func (ve *URLVHostEnumerator) learnedNames(page *core.Page) []string {
+	names := []string{page.ParsedURL().Hostname()}
+	if page.TLS != nil && len(page.TLS.Chain) > 0 {
+		names = append(names, page.TLS.Chain[0].SANs...)
+	}
+	return names
+}
+
+func (ve *URLVHostEnumerator) enumerate(target *vhostTarget, names []string) {
+	ve.session.WaitGroup.Add()
+	go func() {
+		defer ve.session.WaitGroup.Done()
+		target.Lock()
+		defer target.Unlock()
+
+		// Targets without baselines are tried again with the next names,
+		// since every response would look like a new virtual host.
+		if !target.ready {
+			if err := ve.requestBaselines(target); err != nil {
+				ve.session.Out.Debug("[%s] Unable to get baselines for %s: %v\n", ve.ID(), target.url, err)
+				return
+			}
+			target.ready = true
+		}
+
+		for _, name := range names {
+			if ve.session.Stopped() {
+				return
+			}
+			ve.tryName(target, name)
+		}
+	}()
+}
+
+// requestBaselines requests the target with its IP address and with a random
+// hostname, which is what servers return for unknown virtual hosts. The
+// baselines of target are only set if both requests succeed.
+func (ve *URLVHostEnumerator) requestBaselines(target *vhostTarget) error {
+	ipResp, err := probeRequest(ve.session, "GET", target.url.String(), "")
+	if err != nil {
+		return err
+	}
+	ipResp.location = strings.Replace(strings.ToLower(ipResp.location), strings.ToLower(target.url.Hostname()), "", -1)
+
+	randomName := fmt.Sprintf("%016x.invalid", rand.Uint64())
+	randomResp, err := probeRequest(ve.session, "GET", ve.vhostURL(target, randomName), target.addr)
+	if err != nil {
+		return err
+	}
+	randomResp.location = strings.Replace(strings.ToLower(randomResp.location), randomName, "", -1)
+
+	target.baselines = []*probeResponse{ipResp, randomResp}
+	return nil
+}
+
+func (ve *URLVHostEnumerator) tryName(target *vhostTarget, name string) {
+	vhostURL := ve.vhostURL(target, name)
+	if ve.session.HasPage(vhostURL) || !ve.session.InScopeVirtualHost(vhostURL, target.addr) {
+		return
+	}
+
+	resp, err := probeRequest(ve.session, "GET", vhostURL, target.addr)
+	if err != nil {
+		ve.session.Out.Debug("[%s] Error: %v\n", ve.ID(), err)
+		return
+	}
+	resp.location = strings.Replace(strings.ToLower(resp.location), name, "", -1)
+
+	threshold := *ve.session.Options.VHostThreshold
+	for _, known := range append(target.baselines, target.found...) {
+		if resp.matches(known, threshold) {
+			ve.session.Out.Debug("[%s] %s on %s is a duplicate response\n", ve.ID(), name, target.addr)
+			return
+		}
+	}
+
+	if !ve.session.AddVirtualHost(name, target.addr) {
+		ve.session.Out.Debug("[%s] %s is already known as a virtual host on another target\n", ve.ID(), vhostURL)
+		return
+	}
+	target.found = append(target.found, resp)
+
+	ve.session.Out.Info("%s: %s %s\n", vhostURL, ve.session.Out.Green("virtual host on"), target.addr)
+	ve.session.EventBus.Publish(core.URL, vhostURL)
+}
+
+// vhostURL returns the base URL of target with its host replaced by name.
+func (ve *URLVHostEnumerator) vhostURL(target *vhostTarget, name string) string {
+	u := url.URL{Scheme: target.url.Scheme, Host: name, Path: "/"}
+	if port := target.url.Port(); port != "" {
+		u.Host = net.JoinHostPort(name, port)
+	}
+	return u.String()
+}
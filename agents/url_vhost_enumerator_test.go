@@ -0,0 +1,164 @@
+package agents
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+func TestVHostURL(t *testing.T) {
+	ve := NewURLVHostEnumerator()
+	tests := []struct {
+		target string
+		name   string
+		want   string
+	}{
+		{"http://10.0.0.1/", "app.example.com", "http://app.example.com/"},
+		{"https://10.0.0.1", "app.example.com", "https://app.example.com/"},
+		{"http://10.0.0.1:8080/", "app.example.com", "http://app.example.com:8080/"},
+		{"https://[2001:db8::1]:8443/", "app.example.com", "https://app.example.com:8443/"},
+	}
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.target)
+		target := &vhostTarget{url: u, addr: core.URLAddr(u)}
+		if got := ve.vhostURL(target, tt.name); got != tt.want {
+			t.Errorf("vhostURL(%s, %s) = %s, want %s", tt.target, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProbeResponseMatches(t *testing.T) {
+	page := strings.Fields("<html><body><h1>Welcome to nginx!</h1><p>If you see this page, the nginx web server is successfully installed and working.</p></body></html>")
+	other := strings.Fields("<html><body><h1>Intranet</h1><form action=/login><input name=user><input name=password></form></body></html>")
+
+	base := &probeResponse{status: 200, tokens: page}
+	if !base.matches(&probeResponse{status: 200, tokens: page}, 0.9) {
+		t.Error("identical responses don't match")
+	}
+	if base.matches(&probeResponse{status: 200, tokens: other}, 0.9) {
+		t.Error("different pages match")
+	}
+	if base.matches(&probeResponse{status: 403, tokens: page}, 0.9) {
+		t.Error("responses with different status match")
+	}
+
+	redirect := &probeResponse{status: 302, location: "https:///"}
+	if !redirect.matches(&probeResponse{status: 302, location: "https:///", tokens: other}, 0.9) {
+		t.Error("redirects to the same location don't match")
+	}
+	if redirect.matches(&probeResponse{status: 302, location: "https:///login"}, 0.9) {
+		t.Error("redirects to different locations match")
+	}
+}
+
+// vhostServer serves a default page for unknown hosts and distinct pages for
+// app.example.com and intranet.example.com. alias.example.com serves the
+// same page as app.example.com and www.example.com redirects to its HTTPS
+// site like the default host does.
+func vhostServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := strings.Split(r.Host, ":")[0]
+		switch host {
+		case "app.example.com", "alias.example.com":
+			fmt.Fprint(w, "<html><head><title>App</title></head><body><div id=app></div><script src=/static/app.js></script></body></html>")
+		case "intranet.example.com":
+			fmt.Fprint(w, "<html><head><title>Intranet</title></head><body><form action=/login><input name=user><input name=password></form></body></html>")
+		case "127.0.0.1":
+			fmt.Fprint(w, "<html><head><title>Welcome to nginx!</title></head><body><h1>Welcome to nginx!</h1></body></html>")
+		default:
+			http.Redirect(w, r, "https://"+host+"/", http.StatusMovedPermanently)
+		}
+	}))
+}
+
+func TestVHostEnumeratorTryName(t *testing.T) {
+	ts := vhostServer()
+	defer ts.Close()
+
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	silent := true
+	options.OutDir = &outDir
+	options.Silent = &silent
+	s, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ve := NewURLVHostEnumerator()
+	ve.session = s
+
+	u, _ := url.Parse(ts.URL + "/")
+	target := &vhostTarget{url: u, addr: core.URLAddr(u)}
+	if err := ve.requestBaselines(target); err != nil {
+		t.Fatal(err)
+	}
+	if len(target.baselines) != 2 {
+		t.Fatalf("got %d baselines, want 2", len(target.baselines))
+	}
+
+	names := []string{"app.example.com", "www.example.com", "alias.example.com", "intranet.example.com", "app.example.com"}
+	for _, name := range names {
+		ve.tryName(target, name)
+	}
+
+	want := map[string]bool{
+		"app.example.com":      true,
+		"www.example.com":      false,
+		"alias.example.com":    false,
+		"intranet.example.com": true,
+	}
+	for name, found := range want {
+		vhostURL := ve.vhostURL(target, name)
+		if got := s.VirtualHostOf(vhostURL) == target.addr; got != found {
+			t.Errorf("%s found as virtual host: %v, want %v", name, got, found)
+		}
+	}
+	if len(target.found) != 2 {
+		t.Errorf("got %d distinct virtual host responses, want 2", len(target.found))
+	}
+}
+
+func TestVHostEnumeratorBaselineRetry(t *testing.T) {
+	var failing int32 = 1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(strings.Split(r.Host, ":")[0], ".invalid") && atomic.LoadInt32(&failing) == 1 {
+			panic(http.ErrAbortHandler)
+		}
+		fmt.Fprint(w, "<html><head><title>Default</title></head></html>")
+	}))
+	defer ts.Close()
+
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	silent := true
+	options.OutDir = &outDir
+	options.Silent = &silent
+	s, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ve := NewURLVHostEnumerator()
+	ve.session = s
+
+	u, _ := url.Parse(ts.URL + "/")
+	target := &vhostTarget{url: u, addr: core.URLAddr(u)}
+
+	ve.enumerate(target, nil)
+	s.WaitGroup.Wait()
+	if target.ready || len(target.baselines) != 0 {
+		t.Fatalf("after failed baseline: ready %v with %d baselines, want false with 0", target.ready, len(target.baselines))
+	}
+
+	atomic.StoreInt32(&failing, 0)
+	ve.enumerate(target, nil)
+	s.WaitGroup.Wait()
+	if !target.ready || len(target.baselines) != 2 {
+		t.Fatalf("after retry: ready %v with %d baselines, want true with 2", target.ready, len(target.baselines))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/url"
 	"strconv"
 	"strings"
@@ -98,6 +99,42 @@ func Gorequest(o core.Options) *gorequest.SuperAgent {
 		TLSClientConfig(&tls.Config{InsecureSkipVerify: true})
 }
 
+// setRequestHeaders sets a random User-Agent, the spoofed forwarding headers
+// unless disabled, the headers given with -header and the credentials and
+// cookies configured for rawURL on a request.
+func setRequestHeaders(req *gorequest.SuperAgent, s *core.Session, rawURL string) *gorequest.SuperAgent {
+	req.Set("User-Agent", RandomUserAgent())
+	if !*s.Options.NoSpoofHeaders {
+		req.Set("X-Forwarded-For", RandomIPv4Address()).
+			Set("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address())).
+			Set("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(), RandomIPv4Address()))
+	}
+	for name, values := range s.Headers {
+		req.Set(name, strings.Join(values, ", "))
+	}
+	if authorization := s.Authorization(rawURL); authorization != "" {
+		req.Set("Authorization", authorization)
+	}
+	if u, err := url.Parse(rawURL); err == nil {
+		req.AddCookies(s.Cookies.CookiesFor(u))
+	}
+	return req
+}
+
+// dialVirtualHosts makes req connect to the targets serving virtual hosts
+// found by the url_vhost_enumerator agent. Requests to virtual hosts don't go
+// through -proxy, which couldn't resolve them.
+func dialVirtualHosts(req *gorequest.SuperAgent, s *core.Session, rawURL string) *gorequest.SuperAgent {
+	if s.VirtualHostOf(rawURL) != "" {
+		req.Transport.Proxy = nil
+	}
+	dial := req.Transport.Dial
+	req.Transport.Dial = func(network string, addr string) (net.Conn, error) {
+		return dial(network, s.VirtualHostAddr(addr))
+	}
+	return req
+}
+
 func BaseFilenameFromURL(s string) string {
 	u, err := url.Parse(s)
 	if err != nil {
@@ -114,6 +151,22 @@ func BaseFilenameFromURL(s string) string {
 	return strings.ToLower(filename)
 }
 
+func hostnameFromURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+func hasHTTPScheme(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
 func HostAndPortToURL(host string, port int, protocol string) string {
 	return core.HostAndPortToURL(host, port, protocol)
 }
@@ -0,0 +1,219 @@
+package agents
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/proxy"
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// vhostProxy is a local HTTP proxy that Chrome is started with in virtual
+// host mode. Chrome resolves hostnames itself, so connections to virtual
+// hosts found by the url_vhost_enumerator agent are made by the proxy, which
+// connects to the targets serving them. Other connections go through -proxy
+// if it is set.
+type vhostProxy struct {
+	session   *core.Session
+	listener  net.Listener
+	server    *http.Server
+	upstream  *url.URL
+	transport *http.Transport
+	timeout   time.Duration
+	conns     sync.WaitGroup
+}
+
+func newVHostProxy(s *core.Session) (*vhostProxy, error) {
+	vp := &vhostProxy{
+		session: s,
+		timeout: time.Duration(*s.Options.HTTPTimeout) * time.Millisecond,
+	}
+	if *s.Options.Proxy != "" {
+		u, err := url.Parse(*s.Options.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proxy %s: %s", *s.Options.Proxy, err)
+		}
+		vp.upstream = u
+	}
+
+	vp.transport = &http.Transport{
+		Proxy: func(req *http.Request) (*url.URL, error) {
+			if vp.upstream == nil || s.VirtualHostOf(req.URL.String()) != "" {
+				return nil, nil
+			}
+			return vp.upstream, nil
+		},
+		DialContext: func(ctx context.Context, network string, addr string) (net.Conn, error) {
+			dialer := net.Dialer{Timeout: vp.timeout}
+			return dialer.DialContext(ctx, network, s.VirtualHostAddr(addr))
+		},
+		MaxIdleConnsPerHost: 2,
+		IdleConnTimeout:     30 * time.Second,
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to start virtual host proxy: %s", err)
+	}
+	vp.listener = listener
+	vp.server = &http.Server{Handler: vp}
+	go vp.server.Serve(listener)
+
+	return vp, nil
+}
+
+// URL returns the address Chrome should use as proxy server.
+func (vp *vhostProxy) URL() string {
+	return "http://" + vp.listener.Addr().String()
+}
+
+func (vp *vhostProxy) Close() {
+	_ = vp.server.Close()
+	vp.transport.CloseIdleConnections()
+	vp.conns.Wait()
+}
+
+func (vp *vhostProxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodConnect {
+		vp.tunnel(w, req)
+		return
+	}
+	if !req.URL.IsAbs() {
+		http.Error(w, "Not a proxy request", http.StatusBadRequest)
+		return
+	}
+
+	outReq := req.Clone(req.Context())
+	outReq.RequestURI = ""
+	outReq.Header.Del("Proxy-Connection")
+	resp, err := vp.transport.RoundTrip(outReq)
+	if err != nil {
+		vp.session.Out.Debug("[vhost_proxy] Request to %s failed: %v\n", req.URL, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for name, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// tunnel handles CONNECT requests, which Chrome sends for HTTPS URLs.
+func (vp *vhostProxy) tunnel(w http.ResponseWriter, req *http.Request) {
+	target, err := vp.dial(req.Host)
+	if err != nil {
+		vp.session.Out.Debug("[vhost_proxy] Connection to %s failed: %v\n", req.Host, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		target.Close()
+		http.Error(w, "Hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buf, err := hijacker.Hijack()
+	if err != nil {
+		target.Close()
+		return
+	}
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n")); err != nil {
+		client.Close()
+		target.Close()
+		return
+	}
+
+	vp.conns.Add(2)
+	go func() {
+		defer vp.conns.Done()
+		_, _ = io.Copy(target, buf)
+		closeWrite(target)
+	}()
+	go func() {
+		defer vp.conns.Done()
+		_, _ = io.Copy(client, target)
+		client.Close()
+		target.Close()
+	}()
+}
+
+// dial connects to addr, or to the target serving it if it is a virtual
+// host. Other addresses are connected to through -proxy if it is set.
+func (vp *vhostProxy) dial(addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: vp.timeout}
+	if target := vp.session.VirtualHostAddr(addr); target != addr || vp.upstream == nil {
+		return dialer.Dial("tcp", target)
+	}
+
+	switch vp.upstream.Scheme {
+	case "http", "":
+		return vp.dialConnect(dialer, addr)
+	default:
+		d, err := proxy.FromURL(vp.upstream, dialer)
+		if err != nil {
+			return nil, err
+		}
+		return d.Dial("tcp", addr)
+	}
+}
+
+// dialConnect opens a tunnel to addr through the upstream HTTP proxy.
+func (vp *vhostProxy) dialConnect(dialer *net.Dialer, addr string) (net.Conn, error) {
+	conn, err := dialer.Dial("tcp", vp.upstream.Host)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(vp.timeout))
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if vp.upstream.User != nil {
+		password, _ := vp.upstream.User.Password()
+		req.SetBasicAuth(vp.upstream.User.Username(), password)
+		req.Header.Set("Proxy-Authorization", req.Header.Get("Authorization"))
+		req.Header.Del("Authorization")
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy responded with %s", resp.Status)
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+func closeWrite(conn net.Conn) {
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		_ = tcp.CloseWrite()
+		return
+	}
+	conn.Close()
+}
@@ -1,6 +1,7 @@
 // Code generated by go-bindata.
 // sources:
 // static/report_template.html
+// static/takeover_signatures.json
 // static/wappalyzer_fingerprints.json
 // DO NOT EDIT!
 
@@ -69,7 +70,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x97\xea\xb6\xb2\x00\xfa\x3d\xbf\xc2\x97\x24\x97\xee\x4b\x83\x6d\xcc\xd8\xe9\xee\x75\x98\xe7\x79\x26\x37\x2f\x47\xb6\xe5\x01\x6c\xc9\xd8\xb2\x0d\xec\xb5\xff\xfb\x5b\x1e\xa0\x19\xbb\x3b\xd3\x7d\xe7\xc3\xdb\xc9\xde\x60\x0d\xa5\xaa\x52\xa9\x54\x55\x2a\x99\x97\xff\x12\xb1\x40\x76\x06\xa4\x14\xa2\x6b\x6f\x3f\xbc\x78\x1f\x94\x06\x90\xfc\x1a\x81\x28\xf2\xf6\xc3\x0f\x2f\x0a\x04\xe2\xdb\x0f\x14\xf5\xa2\x43\x02\x28\x41\x01\xa6\x05\xc9\x6b\xc4\x26\x52\x3c\x17\x79\xaf\x40\x40\x87\xaf\x11\x47\x85\xae\x81\x4d\x12\xa1\x04\x8c\x08\x44\xe4\x35\xe2\xaa\x22\x51\x5e\x45\xe8\xa8\x02\x8c\xfb\x0f\x4f\x94\x8a\x54\xa2\x02\x2d\x6e\x09\x40\x83\xaf\xec\x13\x65\x29\xa6\x8a\xd6\x71\x82\xe3\x92\x4a\x5e\x11\xbe\x02\x2c\x42\x4b\x30\x55\x83\xa8\x18\x9d\xc0\x2e\x6c\x6c\x40\x30\x82\xd4\x10\xfa\xa3\x5e\xf6\x02\x36\x51\xb0\x79\xd2\xa1\xa3\x0a\x0a\x80\x1a\x55\x87\xc8\x54\xd7\x16\x44\xd4\x83\x42\x88\x61\x3d\xd3\x34\x71\x55\x02\xcd\x84\x80\x75\x5a\x57\x05\xe5\xd0\xe0\xf1\x0a\xa8\x0c\x11\x34\x01\x39\x83\x7b\x44\xc4\xf9\xf6\x2d\x31\x85\xa6\xa5\x62\xf4\xfd\xfb\x55\x57\x13\xf3\x98\x58\x27\xfd\x10\x56\x91\x08\xb7\x4f\x14\xc2\x12\xd6\x34\xec\x06\x5d\x88\x4a\x34\xf8\x76\x41\xdd\x0b\x1d\x14\x7b\x0d\x34\x15\xad\x29\x13\x6a\xaf\x11\x8b\xec\x34\x68\x29\x10\x92\x08\xa5\x98\x50\x7a\x8d\x1c\x08\xb2\x08\x10\xd6\x06\x20\x4a\x82\xc7\x98\x58\xc4\x04\x86\x20\x22\x9f\xc0\x63\x01\x9d\x4a\x70\x09\x96\x16\x2c\xeb\xbd\x2c\xa1\xab\x28\x21\x58\x56\xe4\x07\x8a\xa2\x28\x15\x11\x28\x9b\x2a\xd9\xbd\x46\x2c\x05\x70\xb9\x54\x5c\x96\x7b\xbb\x21\xa3\xce\x4b\x7c\x67\xe0\x70\x73\xd5\xd0\x01\x97\xea\x94\x63\x62\x9d\x66\xa5\x41\x36\x97\xa2\x57\x19\x61\x41\xab\xcd\xf1\x60\xd2\x53\x84\x99\x99\xdd\xe6\x9b\x0e\x1e\x6e\xc7\xc9\xce\xd2\x65\xc7\x11\x4a\x30\xb1\x65\x61\x53\x95\x55\xf4\x1a\x01\x08\xa3\x9d\x8e\x6d\x2b\xf2\x65\xca\x3c\x32\x56\x96\x08\x35\xd5\x31\x13\x08\x12\x1a\x19\x3a\xed\xa8\xd6\xca\x8a\x23\x48\x5c\x6c\xae\xff\x95\x4a\x24\x53\x89\x2c\x2d\xaa\x16\xf1\x6a\x3e\xa3\x49\x71\x32\xa3\x71\xa1\x66\xaf\x53\x9b\xb1\xab\x9b\xbb\x2a\xbf\x5c\x8e\x11\x37\x30\x6b\xc3\xdd\x72\xc6\x5a\xb8\x94\x6f\xd1\xe5\x5d\x26\xb7\xb7\x72\x96\xcd\x17\xab\xbd\x49\x26\x4f\x64\xba\x56\x5b\x4a\xeb\x46\x91\xff\x98\x26\x9f\x12\xca\x5b\x66\xaf\x11\x02\xb7\xc4\xe3\xb7\x5f\x43\x51\x12\xc6\x04\x9a\xd4\x37\xff\x81\xa2\x78\x6c\x8a\xd0\x8c\x13\x6c\x3c\x53\xac\xb1\xa5\x2c\xac\xa9\x22\x65\xca\x3c\x78\x60\x9e\xa8\xe0\xff\x04\x9b\x4c\x3f\xfe\x12\x76\xd0\x81\x29\xab\x28\xe8\x90\x66\x8c\xed\xa1\xdc\x00\xa2\xa8\x22\xf9\xbc\xd0\x1b\x3b\x0e\x34\x55\x46\xcf\x94\x00\x11\x81\xe6\xa1\x46\xc2\x88\xc4\x2d\x75\x0f\x9f\x29\x36\xf9\xde\x41\xc0\x1a\x36\x9f\xbd\xf1\x1f\x32\xb9\x27\x2a\xf8\x1b\x8e\xfd\xfd\x87\x53\x02\xc0\x91\x84\xb0\x8f\x8a\x14\x68\xaa\x84\xfa\x2f\x55\xf7\x84\x17\x20\x72\x86\x85\x08\x05\x6c\x02\x6f\x39\x3f\x53\x36\x12\xa1\xa9\xa9\x08\x9e\x01\x4e\x08\xc0\xc4\xb6\x05\xb5\x23\xe4\x90\x56\x1e\x13\x82\xf5\x53\xca\x2e\x7b\xc4\x55\x02\xf5\x4b\x84\x7e\xe4\x72\x9c\x98\x62\x3f\xe3\xc5\x6d\x58\x09\x03\xc8\x30\x2e\x00\x53\x3c\x82\xf5\x55\xd9\x33\xc5\x31\x77\x18\xac\x41\x89\x9c\xcf\xd2\x33\x95\x4c\x1b\x5b\x8a\x65\x8c\x2d\x95\x3e\x7c\x3b\x34\x11\x55\xcb\xd0\xc0\xce\x63\x9c\xc7\x8a\x38\xaf\x61\x61\x7d\x8e\x92\xa5\x22\x59\x83\xf1\x00\x15\x8c\x08\x50\x11\x34\x4f\x50\x7b\xfa\xbc\x99\xa7\xcc\xa1\x69\xc5\x09\xe0\x35\x78\xc1\xd8\x67\xca\x43\xcc\x47\x2e\xfc\x72\x3e\xbc\x0f\xc0\x12\x4c\x08\x91\xa5\x60\x72\x02\xfb\x00\xc7\xc0\x96\x1a\x4c\xa9\x09\x35\x40\x54\x07\x1e\xa8\xc3\x0e\x34\x25\x0d\xbb\xcf\x94\xa2\x8a\x22\x44\xbf\x9c\xcb\xfb\x61\x4a\xbf\x20\xf2\x77\xb0\x39\xe2\x40\x4c\x80\x0e\x58\xf8\xdf\x25\x6c\xea\x54\x22\x6d\x51\x10\x58\x30\x8e\xed\xe3\xa4\x08\xb6\x69\x79\x82\xb1\xc7\x58\x8f\xab\x47\x94\xc2\x79\x65\x19\xe6\xe7\x3b\x12\xe1\x11\x6e\x62\x2d\x6e\x98\xd0\x79\xba\x53\x87\xe0\x96\x5c\x8a\x4a\xfa\x2b\x00\xe3\xaa\x80\xd1\xbb\x3e\x00\xc2\x5a\x36\xb1\x8d\xc4\xb8\xaa\x03\x19\x3e\x53\xb6\xa9\x3d\x44\x44\x40\xc0\xb3\x5f\x40\x5b\x8e\x1c\xdb\xea\xda\xd3\xcf\x9c\x60\x39\x32\xb5\xd5\x35\x64\xbd\x46\x3d\x4d\xf9\x4c\xd3\xae\xeb\x26\x5c\x2e\x81\x4d\x99\x4e\x32\x0c\xe3\x35\x8e\x52\x92\xaa\x69\xaf\xd1\x9f\x93\x5c\x46\xc8\xa6\xb3\x62\x94\xf2\x36\xed\x22\xde\xbe\x46\x19\x8a\xa1\x72\x54\x2e\xfa\x33\x07\x7f\xe6\x04\x6f\xeb\xa0\xc4\xd7\x68\x27\x9d\x48\xa6\x29\x46\x8b\xa7\xa8\xe0\x3f\x36\x91\x8e\x7b\x7f\x93\xc1\x5f\x2a\xfc\x8c\x87\xe5\xfb\x28\x1d\x00\xf0\x86\xfb\x99\x83\x91\xc7\x4f\xc8\xf6\x78\xf5\x1f\x48\x76\x32\x91\xf5\xc9\x66\x13\x69\x8f\x64\xea\x84\x54\xff\xfb\xa1\x3c\x15\xf7\xff\xfb\x32\xd9\x2a\x12\x55\xc1\xb3\x1f\x2c\x4a\x53\x6f\x91\x7c\x50\x58\x01\xa2\xe7\x50\x78\x20\xca\x97\x0b\x37\x6e\xaa\xb2\x42\x9e\xa9\xf4\xcd\x15\x7b\x7b\xc9\xdf\x95\xf2\x1b\x7d\xc8\xbb\xd2\xf3\xf7\x09\x09\xe8\xaa\xb6\x7b\xa6\x0a\x87\x5d\x8e\xea\x9b\xf8\x89\x2a\x61\x64\x61\x0d\x58\x4f\x54\x07\x22\x0d\x3f\x51\x1d\x8c\x80\x80\x9f\xa8\xb6\x2d\xa8\x22\x08\xeb\xe1\x13\xd5\x56\x79\x18\xe8\x7e\xaf\x09\x7e\xa2\xca\x70\x05\xa6\x36\x35\x02\xc8\x0a\x4b\x8a\xaa\x67\x8b\x40\xa0\x53\x53\x68\x82\xd3\x9a\x12\xb6\x4d\x15\x9a\x54\x17\xba\x4f\x94\x8e\x11\xb6\x0c\x20\xc0\x27\xca\x82\xa6\x2a\x7d\x81\x94\x44\x50\x10\x77\x80\x66\x9f\xb0\x03\x9b\x62\x9c\x37\x21\x58\x3f\x53\xfe\x47\x1c\x68\xda\x57\xb4\xef\xb7\x3f\xad\xc8\xbe\xb0\x9f\xc9\x26\x30\x94\x3f\xa4\x67\xaf\xa6\x95\xa2\x14\x18\x48\x47\xf6\x74\xa3\x3a\x35\x1b\x92\x27\xe5\x01\x19\x7f\x48\x11\xfb\x48\xde\x40\x0d\xf0\x16\xd6\x6c\x72\x44\xcd\x1f\x8b\x39\x3c\x79\xbb\xe3\xc9\xe3\x07\x78\x5f\x8b\x68\xc0\x16\x0d\x03\xcf\xc2\x89\x7b\x5b\x8b\x06\x76\xff\x27\x18\x50\xd4\x3e\xee\x1b\xec\xcf\x54\x3e\x9f\xcf\xff\x72\x7f\xed\x4a\xfe\x9f\xcf\x0d\xaf\xd0\x4e\x0b\x67\xe2\x72\x87\xb8\x4d\x69\xc2\x30\xb1\x6c\x42\xcb\xba\xd4\x03\x01\x49\xc0\x26\xf8\x97\x9b\x0a\xe2\xb4\xe6\xb0\x27\x5d\x93\xcb\x5d\xe9\x11\x4b\xc1\x6e\x5c\xc7\x26\x8c\xf3\x36\x21\x27\x7a\xfa\x9e\xf5\xf9\x99\x64\xff\xf8\xbe\x71\x77\xb0\x08\xb4\xfb\xdb\xf9\x8d\x69\x39\xec\xdb\x06\x56\x4f\xcd\x36\x8a\x7a\xa1\x7d\x43\xfb\xed\x87\x17\x3a\x70\x5a\x7f\x78\xe1\xb1\xb8\xf3\x4d\x70\x04\x1c\x4a\xd0\x80\x65\xbd\x46\x10\x70\x78\x60\x52\xc1\x47\x1c\x6e\x0d\x80\xc4\xb8\x2e\x1e\x0a\x44\x60\xae\x29\x5e\xf6\x3f\x43\x23\xfd\x05\x9c\xf7\x8d\xf3\x26\x40\xe2\xc1\x2b\xf9\x31\xf2\x56\x18\x4c\x0a\xe3\x5e\xb7\xf2\x42\x83\xb0\x47\xc8\xa8\xf3\x6e\x04\xcb\xb2\x06\xcd\x48\xe8\x0a\x04\x6d\x22\x94\xb7\xad\x85\x75\xaf\x11\x01\x6b\x1a\x30\x2c\x78\x28\x06\xa6\xec\xb9\xdb\x3f\x06\x20\x3a\x10\xd9\x91\x90\x0f\xc0\x54\xc1\x61\x0f\xb5\xce\x5b\x04\x75\x01\x69\x50\x7c\x8d\x48\x40\xf3\x20\xfa\xa5\x1a\xe0\x3d\xef\x6a\xec\x8f\xe7\x11\xad\xca\xbe\x2e\x0e\x69\xf5\xdc\x15\x03\xdc\xc1\xdc\xdf\xa5\x23\x6f\x2f\xb4\xd7\x24\xa4\x94\x0e\xc8\x78\x0b\x66\xf6\x45\x54\x8f\x8c\x3e\x90\x72\xe0\xec\x3b\x69\xaa\x78\x80\xec\xa3\x7b\x1c\xd9\xd6\x2e\xc6\xf5\xa6\x4d\x37\xe3\x9e\xe0\x1e\x5b\xf9\x4e\xe2\x49\xbb\xc0\x42\x17\x4d\x6c\x88\xd8\x45\x27\xcd\x2e\x26\x2e\xee\xbb\x96\x87\x76\x21\x49\xef\x93\xe8\x23\xe5\x89\xa1\x55\x3e\x80\xa2\x4c\xac\xdd\x9b\xa7\xe3\x78\x27\xc3\x85\x73\xa2\x00\xcb\xc0\x86\x6d\xbc\x46\x88\x69\xc3\x3b\x93\xf1\x76\xd6\xaf\xef\x8d\x7b\x8a\xf8\x41\x90\xc2\xc7\x13\xae\x1e\x09\xd0\xdf\x67\xda\x9f\x53\x0d\x8a\xfc\xee\x92\x84\xf3\x61\xde\xf9\x71\x84\xe2\x31\xef\xc8\x04\xda\xef\x4c\xf3\xbb\xb8\xa5\xea\xaa\x06\x3c\x1f\x39\xf2\x56\xdc\x51\xa3\xe3\xe3\x05\x66\x7f\x04\xa6\x82\x2d\x62\xf9\xe0\xea\xde\xb7\x3f\x0b\x29\xd8\x88\x23\x6f\x23\xff\x33\x60\xdd\x25\xbf\x68\x51\x75\x4e\xe4\x85\xd6\xd4\x0f\xa5\xe7\x13\xa1\xb9\xc4\xc0\x57\xcb\x91\xb7\x9a\xf7\x71\x36\xf2\xe9\x40\x2f\xb4\xad\x1d\x96\x48\x88\xcd\x0b\x8d\x80\xe3\x2f\x94\x17\x1d\xa8\x28\x14\x2f\xef\x6b\xe4\x7d\xcd\x84\x9b\x7d\x20\x8f\xc0\x30\x0e\x3a\xc8\xc4\x36\xf1\xec\x16\x15\xba\x6f\x2f\xf4\xe9\x93\x0f\xd9\x83\x12\x80\x0e\x3d\x72\xaf\x7b\xf0\xf5\x00\xc1\x38\x0c\xe2\x6f\x47\xba\x4d\xa0\xf8\xae\xba\xce\x23\x57\xd4\x7f\xeb\xaa\x28\x62\xf2\x0b\xa5\x03\x11\x52\xae\x4a\x94\x40\x2f\x1c\x49\xf5\x55\xad\xbf\xc6\xb1\xf9\x6c\x42\xf1\x17\xdf\x34\x74\x83\x3d\x84\xc7\x9a\x18\x79\xfb\xef\x1f\x33\xe9\x34\xc7\xfd\x12\xaa\x0b\x8a\xdf\x79\xbc\x3d\x0f\xe5\x9c\x86\xda\x12\x02\xd6\x23\xd4\x41\xe3\xfd\xce\x6b\x00\xad\x23\x6f\x61\xc8\xee\x38\xf0\x31\x74\xe7\x71\xfe\x85\x36\x0e\xc4\xbd\x5d\xc1\xf6\xdc\x00\xde\xde\xe9\x10\x08\x58\x92\x20\xbc\x8a\xed\x5d\x0f\xf6\xa2\xea\xf2\x89\x28\x58\xa6\xf0\x7a\xea\x75\x18\x48\xfe\x85\x07\x16\xcc\xa4\x9e\xd4\x69\xb1\x37\x74\x99\x56\x4d\xc6\x85\x42\xa1\xd0\x1d\x4d\x94\xca\x44\x2e\x14\x0a\x2d\xff\x59\x2b\x15\x16\x85\x42\xa1\x3c\x5a\xd7\x5b\x7d\xaf\xa0\x36\x1f\x56\x67\xf5\xe1\x98\x4f\x2e\x19\x31\x59\xdd\x2d\x07\xc5\xe2\xb2\x96\x57\x97\xa3\x62\x93\x9f\x55\xd1\x72\xda\xd4\x16\xb3\x61\x5a\x10\x34\xcd\xeb\x50\xea\x15\x9b\xc3\x4a\x75\x02\xbb\xa6\x35\xef\xe4\xfb\xd3\x8a\x20\x20\x96\x99\x36\x6b\xc9\xe9\xb6\x3c\x26\xa3\xb1\x54\x31\x1a\x62\x6d\x06\xd3\xb5\x94\xd8\x62\x9a\x74\x45\xda\x74\xcb\x8b\x4e\xac\xc5\x02\xa1\x44\x17\x2a\x3b\xa7\xb9\x29\xd5\xf3\x7a\xa3\x84\x88\x51\x5e\xe7\xa6\x2e\x40\x86\xbc\x62\xd8\x4e\x21\xb3\x48\xf6\x17\x7a\xc3\xb0\xac\x56\xc7\xe0\xfa\x6e\x4f\xda\x72\xb3\x3a\x4c\xd2\x30\x69\xe7\x88\xa9\x4f\x72\xbb\xd9\x9c\x87\x74\x7f\xd5\x13\xb3\xd9\x3d\x3d\x9e\xf5\xdb\x23\xb9\x4f\xba\x60\x95\xde\xf4\xac\x82\xdc\xea\x15\xc9\xb4\x84\xf9\x02\x6e\xb9\x9b\x9e\x5c\xc8\xf0\xab\xbd\x36\x1e\xe1\xea\xbc\x30\x81\x9d\xee\xb4\x5f\x5b\x09\x05\xbb\x3b\x50\x37\x15\xb1\xb5\x95\x46\x95\x6e\xa9\x23\x8f\x1b\xad\xfd\xbe\x08\xaa\xcd\x56\xaa\x82\x0a\x63\x54\x2d\x15\xa6\x6c\x77\xb9\xca\xca\xe5\x5d\xb6\x20\xcc\xf3\x6e\x69\xdd\x00\x93\x12\x9c\x8c\xcd\xe5\x0e\xae\x62\x49\xbe\x8b\xc8\x66\x5c\x54\x06\xd6\x9c\x2f\xac\x1b\xb9\x5e\x75\xdd\x74\x21\x2d\x42\x7b\x96\x24\xab\xc5\xa4\xcf\xe5\x69\x41\xcb\x48\x33\xb6\x3b\xe7\x49\x72\x2c\x26\x69\xc9\x9b\xf7\x4c\x52\x73\x04\x7a\xec\x26\x6b\xdc\x6a\xd5\xeb\x64\x96\xf4\xac\x3e\x29\xb1\x33\x32\x43\x63\x83\x1b\x0d\x65\x95\x27\xeb\x09\xcf\xe7\x1d\x32\x05\x1c\xdd\x2a\x5a\x7d\x5b\xa3\xcd\x18\xc6\xbd\x5e\x3b\x8d\x6d\x66\x29\xce\x34\x63\x34\x4e\xa7\x72\x13\xc1\x69\xef\xf2\x60\xd2\xe7\xf6\xa9\x4e\x75\x42\x83\x2e\x93\x15\x63\x19\xbc\x4b\x0b\xce\x2c\xc6\x64\xfa\x35\x97\xc9\xf4\x3b\x8a\x31\x5f\x70\x79\xc5\x94\xb3\x6e\x45\xec\x56\x2c\x97\x86\x4c\x51\xa9\x0f\x63\x92\x96\xea\x96\x0b\x3b\x9c\x8b\x49\xfd\x59\xae\xda\x95\x19\x7b\xde\xd6\xd6\x5c\x61\xce\x14\x5b\x19\x59\xda\xab\x88\x5d\x68\x2d\x03\x8d\x67\xda\xde\x4a\x56\xb8\xc1\xa6\x94\xb4\x17\x03\x73\x3a\x1c\x4d\x33\x79\xc8\x03\xe4\x64\xed\xac\xed\x2e\x25\x6e\x28\xe7\x98\x8c\x2c\xae\x2c\x29\x45\x54\x65\x6e\xc9\xed\x45\x49\xb5\x7a\x29\xa1\x21\xa6\x4a\x5c\x7a\x8f\xb8\x8e\xb3\xa9\x12\x7e\x96\x34\xb2\x90\xb5\xa6\x25\x79\x3e\x65\xf3\x10\x8d\x0d\x37\xb5\x80\x44\x21\x9b\xca\x74\x93\xcd\xd9\x1b\xa7\x5d\x05\x0e\x2e\xd2\xfb\xa5\x3d\xc8\x4d\xdc\x05\x10\xd7\xdb\x94\x3c\x68\x64\xca\x95\x58\x5f\x4d\xb1\xe2\x66\x85\x33\xbd\x99\x25\x8c\xbb\xfa\x5e\x9a\x26\xbb\xca\x62\xdd\x5e\xd2\xb2\x80\x9a\x23\xde\x9e\x0b\x5c\x77\x5f\xe6\x5d\xa1\xa6\x6c\x76\x4e\x19\xd8\x8b\x6c\xaa\x4a\xa6\x19\x67\xc3\x6e\x88\x81\xcd\x2a\x26\xb3\x42\x6f\x6f\x65\x27\xb3\x51\x9f\x61\x05\x5b\x63\xe7\x69\x86\x4b\xb1\xf9\xe9\xa4\x36\x98\x27\x63\xd3\xfc\x22\x56\xb3\x32\xeb\xfa\x48\x17\xd4\x94\xdd\x56\xb8\xad\xd6\x6f\x93\x7c\x8c\x03\x03\xbb\xb8\x2c\xee\x47\xeb\x62\x79\x64\x4d\x07\xa6\x38\xe0\x5b\xf3\x71\x32\x2b\x3a\x59\x08\x97\x9d\xa4\x38\xe1\x93\x31\xa7\x3f\x45\x0e\x67\x26\xdb\x68\xdd\x1d\xb0\x74\xb6\xd3\x6b\xad\x86\x9b\xee\x1c\x25\x05\xa6\x59\x2b\x88\x9d\x31\x13\x33\x47\x9b\x99\x3a\xd5\xc4\x39\xce\x77\xe9\x6c\x3e\x93\x6f\xd4\x58\x52\xa9\x8e\xd2\xcd\xed\x78\xc4\x1b\x66\x5e\x93\x67\xac\x91\x91\xea\x92\x99\x8e\xd1\x22\x6e\xb5\x05\x97\x1e\x8f\x73\x6e\xaf\xac\xa6\x48\x4e\x8d\x95\xeb\xd9\x95\xa1\xd7\x3b\xb6\x8e\x99\xd8\x76\xed\x76\xc7\x53\xad\x3b\xae\x2c\x7a\xe5\xca\x96\x11\xca\x13\x5e\x4f\x59\x5d\x5e\x37\xb9\x39\x07\x54\x81\xb6\x39\x93\xe1\x8b\xcb\x9a\x98\x2b\x77\xd1\x32\x29\x91\x7a\x05\xe5\xdc\x72\x87\xcb\xf5\xe7\x43\xd4\x1b\x49\x1d\x65\x55\x9b\x57\x07\x72\xb1\xe4\xc2\x8c\xc6\xb5\xb5\xed\x86\xa4\xab\xb5\xae\x2d\x8a\x0e\x67\xee\x87\x99\x98\x63\x26\x95\x12\x5a\xf1\xc5\xda\x9e\xcd\xc4\xa4\x96\x86\x96\x3a\x2f\x3b\xbd\x55\x0b\x67\x5b\xb6\xd4\xa2\x47\xda\x2c\x36\xc9\xce\xfa\xb9\xc6\x98\xd4\x6a\x9b\x82\x18\x53\x54\xbd\x2b\x0e\x78\x21\x49\x9b\x2b\x31\xbf\x71\xb6\xa4\x0b\xb2\xb1\x15\x5a\x15\x01\x97\x5f\x2c\xcb\xb3\x7d\xdd\x9d\x0b\x93\x6a\xa6\x88\x16\xb3\x7a\xb1\xb7\xa7\x33\x0b\x3d\xb3\xda\xcf\x98\xec\xaa\x21\xaa\x5c\xa9\x94\xb7\xcc\xc6\xa8\x3f\x13\xf2\xb1\x5e\xab\xb7\x9f\x09\xb8\x56\x12\x0d\x13\x2e\xe4\xa1\x9e\xdc\x76\xcd\x71\xbd\x5f\xd1\xf2\x76\x25\xbb\x2b\x8d\x07\xc3\x54\xc3\x5e\x97\xdd\x39\xd9\xcd\xe9\xd9\x4e\xe2\x0a\xa8\x25\x97\xdb\x13\x6d\x2f\x0f\xa0\xb0\x63\xd5\x94\xb2\x42\x6a\xac\xa9\x57\x88\x2a\xe5\xdc\xb1\xd2\x9c\x96\x2c\xcd\x04\xc5\x51\xa1\x53\x91\xe9\x02\xa3\x8f\x74\xa0\x8c\x57\xad\xb9\x2c\x5b\x35\x4b\xe6\x70\x5a\xa8\xee\x8a\xd3\x8c\xdd\x9c\x69\x31\xbe\xb1\xc9\x16\xb1\xab\x15\x17\x76\x55\x4f\x09\xac\xa5\xc4\xaa\x5b\x91\xcd\x95\xc4\xfc\x42\x58\x33\xb1\x49\xa5\x98\xeb\x97\xea\xc4\x91\x9b\xb1\x5d\x4f\x18\xa5\x5b\x93\x5c\xbe\x50\x4c\xab\xe5\xe9\x76\x3e\x56\x1b\x82\xb2\xb3\x2b\xdc\x50\x1b\xf2\x75\xd1\x90\xf9\x58\x6b\x56\x48\xce\x20\x23\x29\xdd\x41\xb5\xaf\x2e\x3b\x23\xb3\x63\x4e\xd3\x31\xa9\xb7\x6a\xec\x16\x0e\x3b\x01\xf3\x06\xec\xd7\xe5\x81\x3e\x15\xf5\x66\x6f\xc8\xed\x0b\xdd\xcc\x5a\xb2\xaa\xeb\xb2\x3e\xc0\x0d\xba\xdd\xe5\x35\x99\xa9\xc0\xb1\xea\xa4\x17\xc5\xfc\xb2\xd0\x75\x8b\xfb\x5a\xab\xd6\xd9\x6e\xca\x86\x52\xd0\x2a\xfd\xec\x80\xad\xa9\xcb\xad\x34\x2e\x21\xa3\xb8\x1e\xf6\xea\x4a\xbb\xd9\xd6\x5a\xdd\x76\xb7\xa6\xb6\xf7\xcb\x0a\x69\x76\x92\x56\x81\x4e\xf5\xeb\xab\x2d\x5b\xc9\x8a\x3b\xba\x31\xcf\x42\xe8\x74\x96\x42\xb9\x56\x1e\x2a\x7a\x47\xe1\xe5\x32\x71\xcc\x94\x98\x63\x6b\x7c\x61\x68\x2d\xd2\xe9\x0e\x5b\xc9\xca\xd6\xd8\xdc\x08\x05\xae\x57\x62\x46\x8a\x5c\x6d\xaa\xc5\xf2\x62\x49\x0f\xed\xe5\x6e\xb0\x53\x17\x74\x25\xa5\xc8\xb5\x1c\xa1\x47\xac\x2d\x76\xb1\x55\x2c\x4c\x4b\x44\x15\x48\xd6\x06\x83\xa2\xee\xca\xdd\x7d\xdf\x1e\x74\x56\xdd\xa1\x51\x8b\x2d\x95\x2d\xc9\x37\x27\xdb\x36\xc7\x72\xb4\xcc\xc6\xe4\xba\x94\x2a\xdb\x15\x85\x17\xa1\x33\xdf\xe7\x26\xdd\xf6\x9a\xd9\x4a\x7a\x3a\x5d\xae\xd7\x8c\x6c\xac\xeb\x6c\xf6\xf5\x64\x79\x9f\x5a\x5b\x39\x31\x3f\xad\xf1\x05\x80\xf3\x3b\x31\xd6\x2a\xe4\xdc\x66\x2c\x3f\x37\x45\x3e\x99\xb6\x45\x24\xd3\xd9\x8d\x5c\x93\xda\xdd\xa1\x94\xef\xeb\xab\x64\xa9\x89\x57\xf9\x79\xbb\x83\xb7\x69\x9e\x2c\x5a\x69\x11\xe5\x8b\x48\xd6\xa7\x12\x9b\xa7\x57\xf5\xf2\x58\x63\x36\xe3\xf1\x3c\xb5\x58\x6a\x30\xdd\x47\x25\x6b\xc5\xa6\x06\xb1\x4e\x5b\xb7\x67\xb1\xe6\xbe\x99\x57\xa5\xa6\x21\xdb\x32\x1a\x16\x53\x68\x3b\x64\x54\x92\x6e\x0a\x4c\x36\x26\xb0\x31\x7e\xc5\xe2\x66\x31\xb6\x1d\x32\xa2\x1e\x53\xd6\x43\x5b\xab\x4a\x33\xcc\xb5\xa6\x74\x72\xb0\x61\xa6\xb1\xaa\x41\x77\x85\x3e\x6f\x25\x01\x6f\xb4\x92\xc6\x06\x28\x9d\x82\x90\xd5\x80\x3e\x63\x71\x51\xd7\x20\x9e\xe8\x83\x4c\x85\xdf\x36\x26\x29\x7e\x30\x75\x9a\x3d\xa0\xe6\x93\x15\x00\xc4\x6e\xa9\xb1\x2b\xaa\x4d\x51\xa1\xe9\x51\x95\x2e\x77\xf9\x8e\xeb\xcc\xf4\x7d\xbd\x94\xee\xeb\xa5\x89\x82\xe6\xab\x5e\x0f\x8c\xaa\xd6\x56\x48\x97\xb5\xe4\x62\x9d\x04\x92\xc4\x57\x6d\x36\xcd\x16\xfb\xe2\xa2\x97\x77\x33\xd2\xac\x24\x89\xab\x5d\x7f\xbc\x69\xb8\x7a\x87\x11\x93\xb1\x5c\xa5\xbb\x68\x0c\x27\x6c\x12\xb3\xb1\xed\xba\x0e\xca\x75\x4e\x2c\x77\x1a\x78\xdd\x77\x10\x2a\x2c\xe5\x71\xa3\xb0\xce\x57\xf0\xd8\x5c\xf3\xf5\x4a\x95\x17\x86\xbb\x65\x6d\x56\x9e\x0d\x06\xcb\xe6\xc4\x26\x83\x4a\xd6\x2e\xaa\xd2\xae\x67\x89\xeb\x39\x4a\xaf\xf8\xf4\x32\x29\x0c\xf2\xed\x76\x77\x5e\xc9\xd5\xc0\xc8\xdd\x2b\x6c\xdb\xd4\xf2\x9b\xd1\x5e\xb7\xf5\xd4\xba\x30\xcf\x6f\xe5\x95\xb9\x1b\xcd\x06\xfd\x5c\x7b\xd4\xcd\xf4\x00\xdf\x49\x1b\xa5\xa4\x51\x29\xb9\x29\xb6\x46\x73\x9d\x82\xb5\x28\x8d\x60\x71\x36\x80\x55\xec\x76\x8b\xc9\x0e\x76\x8a\x83\x4d\xa7\x91\xee\x2c\x6b\xe3\xcd\x70\x53\x8b\xb9\x68\x34\x35\x6b\x7d\xb0\x9b\x49\x3b\xa9\x3e\xdc\x32\xc9\x41\x36\xdf\x94\xf6\x96\xcc\x6d\x7a\xcb\xbc\x59\xb1\xfb\xd8\xa8\x95\xdd\x45\x5b\xb3\x4b\x90\x18\xbb\x95\xde\xab\x17\x62\xa5\x51\x16\x16\xf9\x49\xcd\xb1\x69\x90\xca\x36\x16\xc2\x78\x9b\x6a\x69\x79\x21\xb7\x2a\xaa\x7c\x2a\x2b\xb7\x0c\xdb\x2e\x8d\x54\x7e\x38\x65\xd8\x31\xd3\x05\xf3\x2d\xe3\xae\x36\xed\x4c\x29\x37\x2f\xca\x46\x17\x8c\xf7\xec\xae\x3b\x9a\x81\x32\xef\xac\x5a\xfd\x4d\x35\x59\x5c\xd4\xea\x6e\x7f\xbe\xb2\x8a\xd9\xc9\x68\xc4\x99\xfc\xaa\x45\xa7\xd8\x9e\xed\xc6\xc4\xb1\xbd\xd2\x00\xca\x2f\xfb\x39\xd2\xcd\x4b\xfd\x4a\x7e\xbd\xd7\x26\x5a\x56\x5c\x48\x5b\xd7\x49\x4b\xe6\x60\x4f\x66\x3b\xa3\x6a\xb5\x9c\xb4\x03\x7b\xab\x66\xb1\x38\xaa\x26\x2b\x99\xcc\x24\xdf\x1f\x55\x54\x35\x2f\xe9\xb9\x64\x1a\x96\x0a\xf2\x6c\xca\x74\x4a\xc5\xe1\x1e\x8b\xb2\xc5\xb6\xb5\xf4\xac\xe6\xb6\x6a\x15\xba\x3b\x90\x19\x7b\x3f\xcb\x8e\x8a\xa8\xbb\x97\xa6\xa0\xa0\x4a\xa2\x9e\x6a\xca\x39\xb7\xb7\x32\x9b\x96\xba\xa5\x4d\x59\xe8\x10\xb3\x4d\x66\xf5\xae\x5e\x24\xa6\xa0\xe6\x46\xf3\xb2\xd0\xc8\xf7\xd1\x6c\x44\x60\x3d\x4d\x92\xa8\xd8\x2f\x75\x06\xaa\xd2\xed\x8d\xf2\xd3\x4d\x65\xa6\x2d\x0d\x09\x70\xe6\x44\x06\xdd\x6e\x0b\x77\x99\xd8\x40\x62\xc9\x0c\xda\x92\x43\xfa\x19\x33\x03\xbb\x8c\x14\xe3\x86\x8e\x12\x9b\xd2\x75\x6d\x99\xeb\x15\xda\xd9\x96\x64\x55\xb2\x45\x31\x59\x1b\x36\xc7\x06\x59\xf2\x29\xab\x69\x16\xf9\x75\xb7\x96\xdf\x17\x8a\x8d\x7e\x9a\x29\xb5\x4a\xb9\x2d\xd3\x4d\x73\xb1\x6a\x4d\x12\x1b\xce\xcc\x19\x4b\x39\x89\xd3\xd6\xee\x7a\x31\xae\x2c\xd3\xb1\x79\x46\xef\xb7\xf7\xcb\x1a\x9d\x9b\xc7\x64\x5a\x6c\xcd\x67\x3b\x7e\xd7\x87\x86\xba\xc4\xf4\x2e\x27\xd0\x79\xb5\xae\x6a\x4a\x85\xc5\x4e\xb3\xe7\xe0\xc2\x50\xdb\x3b\xdd\x4a\x7e\xdb\x2e\xce\x16\x36\x6c\xd7\x8a\x0d\xa7\xc7\x8c\x96\xc2\x6a\x3e\x67\x8c\xed\xc2\x29\xee\x5d\x4e\x53\x6c\x5d\x9a\xd7\xb4\x05\xae\xb0\xe9\x7c\x69\x69\x6d\xb1\x9d\xd7\xd8\xfa\xce\xaa\xd5\x72\xe3\x59\x2b\xa3\xf6\x74\x30\xd5\xd3\x23\x7a\x9d\x4b\xa9\x44\xca\xf4\x54\x1b\xcf\x73\xe9\x5a\xd2\x1c\x16\x31\xbd\x58\x97\x6a\x15\xd2\x4f\xb5\x5b\xfa\x6e\x35\x90\x2d\x4e\xc9\x0a\x2c\x3d\x80\x36\x5b\xdb\xef\x04\xbb\x52\x2d\xef\x49\xbf\xdb\x49\x75\xe7\xfd\xee\x58\x4c\x55\xf2\x75\x9a\x4d\x82\x26\xea\xc7\x94\x0c\xde\xa0\x05\x69\xf6\x9d\x18\x16\x36\x3d\x76\x6e\xb2\x99\xaa\x58\x51\xb3\xb9\x56\xbf\xc1\x95\x8a\x85\x59\x6d\x52\xdd\xd2\x29\xd3\x5d\x37\x9a\xb9\x4d\xb7\xb6\x17\xd4\x14\xe4\x6a\x9c\x32\x19\x8c\x9b\xa8\xbf\x99\xa4\xbb\x72\x81\x75\x44\x3b\xd6\xaf\xc4\xb4\xac\x00\xda\xbc\x5b\xe0\xe5\xf4\x10\x18\x53\xa9\x50\x1a\xb5\x45\xa9\x62\xa5\xda\x6e\x81\x6c\xc6\x7c\xda\x72\x15\x58\x88\x15\x53\x45\xde\xd8\x64\xf0\xb4\xd2\x8e\xed\x69\xc3\xca\x14\x4a\x58\x27\xa5\xb9\x8c\x76\x4b\xb8\x5f\xad\xda\xf2\xdc\x18\xd5\x0b\x1c\x1c\x76\x63\xcd\x1a\x23\xf7\xe9\x0a\x9c\x55\xdc\xee\x30\x9d\xaa\x2c\x8b\xab\x55\x95\x14\x39\x29\x3f\xe5\x76\x25\xab\xc0\xaf\x27\x13\x4b\x41\xb1\x1a\x62\xe4\xee\x0e\xc0\xdd\x34\x56\x73\x18\xa9\x30\x58\x14\x56\x72\x9d\xb7\x26\xc9\x91\xc2\x0e\x3c\xb7\xa0\x30\x9a\x4c\x7b\xc3\x56\xba\xb4\x68\x34\x5e\x4f\x63\x09\x40\x23\xaf\x91\xa2\xbd\xa3\x3a\x90\x2a\x50\x25\xdf\x81\x89\x1c\xbc\xae\x43\xa8\x0e\xd8\x04\x9f\x9e\xb0\x86\xd1\xb2\xcb\xe2\xc8\xdb\x89\xaf\xf4\x42\x07\x5e\x61\xe0\x2c\x06\x59\x15\x81\xa3\x73\x3c\x5e\xc7\x22\x4c\xac\x36\x36\x34\x77\xbe\xcb\x14\x7c\x8d\x73\x09\x2e\xc1\x26\x2c\x4d\xd5\xfd\xd3\xf4\xd5\xdd\xc3\xf4\x4d\x4e\xa5\xe7\xb1\x7c\x26\x5d\xde\xf7\x18\x73\x9c\x05\x7c\x2b\xc5\x36\x47\x64\xd0\x28\x6c\xa6\xf2\x70\xba\x37\xf8\x3d\x4e\x5b\xfa\xbc\x65\xa4\x16\xd2\xd0\xa9\xc7\x72\x80\x27\xe3\x0a\xdb\x57\x33\x2b\x75\x8f\x03\xb8\xf7\x0e\xd4\x5f\xe8\x00\xe7\xb7\xbb\xe8\x8b\x68\x65\x25\x04\x0d\xdb\xa2\xa4\x01\x33\x70\xfb\xc0\x0a\x6c\x69\x4d\xe5\x2d\xda\xc0\x86\x01\xcd\xc4\xca\xa2\xd9\x04\x9b\x4a\x64\x69\x5b\x17\x0f\x85\x1f\xd3\x35\xe9\x25\xe1\x98\x29\x19\xf5\x8d\x38\x6a\x0e\x32\x4a\x93\xec\xd2\xad\xa9\xa1\x90\xbe\xb2\x9f\xad\xf2\xb3\x1e\x2b\x68\xf5\x71\xa7\x06\xb8\x66\x79\xe9\x9a\x68\xb0\x49\x59\xd5\x5c\x46\x6c\xd4\xbb\xe5\x3d\x33\x63\xff\x22\x5d\x7f\x20\x9f\x63\x75\x99\xce\x71\x9f\xa8\xe6\x6a\xa4\x4f\xe5\x9d\xc8\x18\x9c\x31\x2f\xb2\xe6\x50\xe5\x97\x93\xc2\x02\x37\x1a\xbb\x4c\xcf\x1c\x64\xa6\xe6\xaa\x51\x01\x55\x89\x46\xcd\xda\xbe\xb1\xad\x96\x2d\x29\xb5\x65\xb6\x8d\x4e\xac\xc8\x64\x57\xc3\xce\x5f\x9f\xac\xeb\x54\x0e\x3f\x21\xc0\x12\xb0\x09\xff\xc5\x26\xf2\x09\xf6\xa4\x20\xfe\x31\x35\xe9\xf2\x6c\x6f\xe6\x47\x29\x20\x6f\x46\xdc\xac\xe5\xf4\x4d\xa5\xda\x6a\x02\xd9\x58\xec\xea\xbd\xa2\x25\x71\x74\x79\x6b\x97\x5b\xbd\xe1\x6e\x53\x72\x92\xd6\x02\x9a\x79\x81\xae\x6c\x45\xa5\xdf\x6b\xe7\x4a\x35\xe5\x0f\x50\xf3\x5f\xf1\x38\x55\x86\x0e\xd4\xb0\xa1\x43\x44\x28\x27\x88\x9d\x50\x58\xa2\xa6\x76\x18\x32\x51\xa0\x66\x48\xb6\x46\x09\xc1\xd1\x17\xa5\x61\x59\x56\x91\xfc\x87\x98\xe1\xd8\xf0\x5f\xc9\x44\x26\xc1\x32\x61\x36\x8b\x0d\x3f\x60\x40\xde\xce\x6b\x7b\x9e\x56\xcc\x1c\x64\x53\xb5\x76\x1d\xa6\xc7\x95\x9e\x39\x56\xeb\xdc\x80\xb8\xe9\xf2\x3c\xb9\x74\xf3\x73\x5a\xce\x0a\x9b\x55\x8e\x9d\x25\x3b\x42\xa5\xb3\x4d\x97\x5a\x3d\x6b\xbf\x15\xf9\xdc\x4a\xfe\x22\x03\xa8\x78\xfc\x8f\x4d\xe9\x2d\x2a\x3e\x9e\xca\x1c\x89\x81\xb6\x66\x4f\xa6\x08\xa5\x47\xfd\x7e\x8d\xee\xf2\x70\x59\xaa\x67\xc6\xb3\x86\x03\xe6\x0d\x9d\x96\xcb\xbc\x4d\x86\x0e\xa9\xc0\x8a\xb6\xdf\x6e\x67\x60\xd9\x8d\xd5\xe8\x65\xa3\x22\x36\x68\x29\xb6\xfb\xfb\xa6\x72\xe8\xc7\xda\xfe\xd6\x19\x8d\x07\xf1\xbb\x7f\x71\x09\x26\x91\x39\x72\x24\x2c\xfd\x80\x29\xe3\x61\xb1\xe2\x74\x17\x43\x09\xb9\x2b\xd1\xdd\xd1\xca\x64\x5a\x51\x67\x83\x9e\xc6\x33\x62\xbf\xbb\x53\x63\x25\x86\xee\xd9\xcb\xde\x62\xdf\xee\x3b\xf9\x7e\xb6\x93\x24\xcb\xe4\x6a\xd3\x82\xbd\x79\x6c\x6d\x8c\xb8\x7f\x70\x7a\x3f\x26\xe9\xe3\xb9\x86\xdd\x51\xcd\x59\x14\x78\x3c\xa1\x2d\xa9\x97\x12\x6b\x0e\xbb\xc9\x95\xd2\x39\xdd\xec\x36\xad\x3c\x67\x17\xf1\x0e\xd1\xd3\x41\x7a\x94\x8b\xb5\x8a\xf4\x7c\xa3\xab\x58\xa8\x94\x0b\x6b\x59\x04\xa5\x5a\xaf\x33\xfe\x27\x94\xd0\xe7\xf9\x64\xf7\xe9\xc1\x60\xdd\xaa\xce\x67\xc4\x5e\xf1\xcd\x79\xd6\xad\x2d\xeb\xc9\x06\xb7\x67\x3b\xf3\x4d\x6e\x2d\x30\xc3\x8d\xd4\x41\xbb\x6a\x71\x21\x90\x62\xb1\x43\xb3\xb5\xb4\x99\x5f\x1a\xed\x5a\x16\x5a\x30\x23\x8d\x45\x3b\xf5\x55\x7a\x4e\x08\x3a\xc9\x2e\xdb\xc6\x09\xd4\x0d\x0d\x10\xf8\x7e\xa8\x51\x0a\xb3\x0f\xc6\x87\x9a\x63\x98\xfa\xe4\x68\x21\x38\x84\x3b\x86\xfa\xe3\x82\x66\x5b\x9e\xe4\x1f\x33\xb1\x2c\x4d\x15\x61\x84\x7a\xf6\xa0\x46\x0f\xa5\xbf\x47\xa9\x18\xa5\x8a\xe1\xf9\x88\x7f\x26\xe7\x00\xed\xfa\x9c\xe3\x05\x1f\x4f\x77\x6e\xe4\x42\x9c\x87\xe0\x35\x95\x7a\x3e\x3b\xff\x8a\xfe\x78\x35\x9c\x13\x97\xb0\xf9\x1a\x79\xf0\xb0\xae\x99\xd8\x36\x9e\x28\xff\x6c\xf6\x91\x52\x11\xe5\x07\xea\x1b\xc8\x2f\xb7\x22\x21\x30\x1f\xfd\x38\xc1\xaf\x11\xbf\x61\x84\x7a\x0e\xf1\xf9\x46\x45\x81\x40\x54\x07\x46\x9f\x03\x18\xd4\xeb\xeb\x2b\xc5\x50\xdf\x3d\x66\x9f\x9d\x1d\xd0\x58\x3b\x79\x3a\x3d\xec\x7a\x27\x09\x1d\x43\xee\x1f\x35\xf3\x4f\x36\xfe\x10\x0d\x9f\x23\x7b\x7e\x9c\xf2\x9e\xb3\x16\x0e\xe3\x15\x1c\x00\xfb\x50\x3d\x04\x78\x15\x89\xcf\x5e\x49\x50\x7f\x2c\x5a\xc3\xf0\x30\x29\x61\xdb\xaa\xe8\x31\xe2\x08\xef\xc6\x51\xcb\xcd\xf3\x93\x9b\x09\x4e\x11\xea\x39\x08\xd3\xdf\x98\xd2\x1b\xe7\x6d\xfe\x9c\xbd\x46\xfc\x9e\x17\xf4\x9d\x9e\x53\xde\xcf\xa5\x0a\x8f\xc8\x82\xbc\xb3\xf0\x48\xee\xec\x04\xf3\x26\x3c\xcb\x8c\x63\xa4\xed\x22\x6f\x7d\x13\x3a\x2a\xb6\xad\xeb\x1e\x97\x67\x4e\xf7\xc9\x46\x70\x4b\xfe\x1c\xd9\x7e\xcf\x3f\x42\xf6\x31\x97\xea\x2f\x92\xdd\x85\x5b\xf2\x09\xc9\x97\x87\x6c\x8a\x49\xd1\x57\x07\x5e\x7f\x4c\x53\xf5\x03\x4d\x25\x5e\x68\xa9\x8b\x05\x24\x52\x47\x49\xbc\xa9\xc6\xbc\x8a\x30\xef\x27\xc8\xbc\x20\xa6\x8d\x04\x7f\x90\x67\x3f\x85\xfa\x20\xd7\xa6\x76\xc2\xdb\x9f\xbe\x51\x87\x52\x3f\x9b\xe0\x8a\xc4\x6b\x4d\x79\x23\x17\xd2\x5b\x3e\x18\x3d\x7b\x8a\x1a\x62\x07\x9a\xaf\x91\x3d\xc6\xfa\xe8\xd8\xf2\xac\xde\x26\xaf\x11\x1b\xdd\x6f\xa0\x63\x07\xbe\x46\xfc\xbc\x91\x25\xc6\xfa\x4c\x25\x4a\xc9\x4f\x7e\x38\xd5\xaa\xaa\x2e\x53\x4e\x5c\x95\x42\xa2\x14\x60\x9d\x02\x7b\xf6\x37\x3a\xbf\xe6\x1d\xdd\x3e\x20\x4a\xe4\x8c\x5b\x1e\x90\x0b\x9a\x22\xd4\xb3\xef\x83\x1e\x59\x15\x20\x26\x68\xaa\xb0\x7e\x8d\x60\x03\xa2\xd1\x79\x12\x47\xe4\x30\xfd\x27\x68\x41\xcd\x82\x7f\xea\x14\x0d\x7a\x8f\x15\xab\x58\xe8\x14\x0a\x85\xb2\xc1\xd4\x59\xc3\x3f\x45\x63\x8b\x9d\x69\x65\xae\xa6\x62\x93\x54\x7f\x52\xe3\x6c\x7e\xd7\x5d\x37\xfb\x9d\x3d\x29\xa9\x46\x4b\xe4\x20\x97\xee\x4e\xa6\x53\x75\xa9\x6f\xb8\xdc\xbc\xb5\xf1\xfa\x94\xe6\xc5\xc6\x6c\xee\xc1\xc9\x56\x0a\x85\x42\x6f\x5b\xa8\x4d\x5b\x6e\x8a\x2f\x14\x0a\x55\x9e\xd1\x2a\x83\xe9\x30\x85\x7a\xdc\x62\x3c\x95\xf8\xa1\x32\xaa\xe7\x84\x8a\xe3\x16\x1b\xe3\x72\xc9\xad\x02\xb1\x61\x0b\x33\x45\xd5\x50\x13\xeb\xbb\x2c\x41\x9b\xf1\x32\xb5\x59\x54\xdb\x6e\x45\xaa\x18\xfc\xa0\xdb\x2b\xf5\xb9\xb9\xe3\xec\x2b\xf2\xde\x9d\x55\x8b\xa8\x94\xce\x20\x92\x4b\x5b\x23\xce\xd8\x5b\x96\xb4\x9a\x0d\xd2\x7b\xb9\x52\xf8\x6b\x7f\xca\x29\x87\xd3\x84\x8c\x6e\x67\xd7\x4d\x69\x96\xcd\x49\xfd\x0c\x9d\x1c\x8b\x19\x9a\x75\xa4\xb9\x9a\x36\xf5\x49\xbf\x9b\xa6\x73\x69\x32\xeb\x3a\xfc\x14\xd9\xe9\x01\x90\xec\x9a\xc9\x6d\xd5\xfd\x20\x2f\x32\x76\x4d\x61\x61\xaa\xbf\xc8\xe7\x9d\x8d\x5a\xd3\xd2\x6b\x89\xcf\x75\xe0\x9a\x07\xbd\x4d\x09\x4d\x92\x62\x59\xc1\x1b\x75\x9d\x1b\xf7\xf2\x8d\x39\x2b\xad\xc9\x78\x1a\x73\xf6\xb1\x58\xa9\x6d\xcf\x49\x3e\x25\xa2\xbe\x2e\xb6\x99\x4c\x66\xb2\x02\x3c\x9a\x71\xcd\x79\xd3\xe4\x3b\x5c\x55\xeb\x31\x63\x30\x37\x4c\x89\x5f\x99\x73\x42\x2f\x56\x1a\x37\x4e\x65\x92\xdb\xa4\x34\xd3\x89\xd4\x01\xbd\xa5\xc6\xb1\x7a\x8e\x61\xa5\x61\xd2\x4a\xe6\x96\x0b\xb2\x8e\x99\x1b\x69\x9d\xa9\x71\x9b\xfd\xaa\xc8\xa0\x09\xa7\xc8\xa9\xfe\x24\x95\x9a\x4a\x68\x3a\x4f\x2d\x67\xd6\x72\xb3\x6d\x32\x74\x4c\xac\xf4\xda\xe9\x7e\x3a\x5f\xce\x3b\x4e\xc6\x95\xd0\x06\x14\x19\x37\x3d\x5f\xaf\xfa\x23\x69\x43\x67\x93\x8a\x9d\xb4\x66\x66\x9d\xdb\x66\xfb\x25\xb8\x37\xcd\x4e\x47\x62\x8d\x7e\x41\x14\xa6\xe5\x7c\x85\x2e\x29\x5d\xb6\xd3\xdf\x0f\x60\x4c\xe4\x94\xfd\x9c\xc1\x83\xb4\x1e\x73\xca\x9b\x4c\x2d\xab\x6c\x9c\xec\x68\x5e\x27\xe5\x02\x58\x88\x46\xaa\x3b\x45\x80\x9e\x0c\x64\xa6\x29\xf5\x63\xd9\xc5\x50\x49\xa5\xd8\xaa\x5e\x27\x29\xab\x4d\xd7\xcc\xfe\x38\xbb\x32\xe8\x58\x2b\xcf\x6c\x40\xba\xbe\x32\x25\xb5\x36\x4b\x92\xf1\x02\x09\xb5\x1d\x3d\xc9\x0c\xea\x43\x35\xeb\x74\x0a\x4c\xae\xd5\xe3\x4a\xba\x38\xd6\xcc\x05\x33\xb5\xb9\xf1\xde\x6d\xd5\x7b\x2d\xc4\xb7\x94\xc1\x2c\x69\x8c\x26\xe3\xb2\xd6\xdf\xf1\x19\x66\x30\xeb\xe4\x73\x7d\x40\x27\x9d\x4e\x69\x4b\x83\x62\xa3\x9c\xda\x0a\x9c\x5e\x01\xb1\x4e\x11\x69\x83\xad\x0a\x14\xdd\xd6\x36\x34\xd3\x1f\xe4\x84\xcc\x66\x5b\xce\xcc\xd9\xa1\x2c\x26\xbb\xa3\x5c\x7e\x90\x29\xa5\xac\x0c\x5f\xde\x3b\x56\x69\x4b\x2f\x19\x0d\xcd\x67\x8b\xa2\x99\x75\x67\xb3\xe4\x7c\xce\x60\xd3\x4d\x2d\x88\xb2\xdf\xba\x9b\x7e\x17\xc1\x7a\xb5\x9d\x54\x17\x7a\x25\x96\x4d\x67\x27\x20\x53\xe9\xf5\x7b\x9d\xe6\x46\x50\x56\x7a\x71\x40\xdb\xa9\xd8\xc6\x29\xcc\x16\x62\x73\xd1\xd5\x94\x59\xce\x46\x2c\x74\x35\xbd\xc9\x19\xed\x7a\xc9\xb2\xdc\xb4\x53\x55\x94\x45\x31\xbd\x68\xc6\x18\x6b\xd3\xb6\x97\x53\x9a\x66\x98\x8d\x60\x0b\x88\xef\xa4\xe5\x49\x37\x2b\xee\x9d\x4e\x21\x29\x88\x4d\x5c\x5f\xa1\x1c\xdb\x33\x49\x8e\x2e\x09\xc9\x9d\xdb\xae\xf7\xb2\xa4\x59\x2f\xb9\x7b\x41\x27\x9b\x0a\x9f\x6b\xf5\x4c\x44\x9b\xe3\x89\x35\xe7\xcd\xc1\x76\xbb\xa9\x59\xb9\x18\xaf\x5b\xcb\x22\xee\xcf\x39\xba\x95\x44\x8e\xae\x39\xc9\x72\xad\x52\x5f\x6d\xf2\x22\xa7\x57\x46\xb3\x5e\xba\x4f\x6f\xf6\xe6\x48\x9a\xcc\x73\xeb\x79\x6a\x5d\x98\xf5\x44\x9e\x5b\xed\xa4\x89\xd4\x96\xd7\x82\x41\x97\x07\x6e\x2d\x3d\xd9\xcb\x48\xc8\xd8\xf6\x5c\x12\x77\x46\x67\x96\xe1\x4a\x5b\x8d\x6c\x70\x2e\x9d\xdb\xd4\x9c\x6c\x2e\x36\xca\x3b\x8d\x7a\x4f\x72\xc6\xca\xa0\x9f\xcd\xbb\xe3\x19\xe8\x76\x5c\x52\xcd\xd5\x74\xcb\x6a\x59\x56\x69\x3b\x5e\x6d\x84\x4c\xb9\xdb\xaf\x8e\x95\x5e\x4a\xa8\x15\xd3\xbc\x43\xf3\x7a\x71\x39\xc4\xb9\x58\x89\xde\xf5\x75\xba\x2f\x4f\xf8\xf9\x5c\x9d\xd2\x4e\x73\xe2\x64\x46\xa9\x0a\xb2\xa4\x99\x6c\xd5\xbb\xa6\x9a\x17\x39\xe4\xe1\x25\x6d\x1c\x81\xd7\x53\xe6\x6e\x96\xdd\xe9\xe3\x92\x20\x4d\x67\xf2\x94\x75\xf4\x12\x6d\xe8\x4b\x4b\x4a\xb6\x21\x67\xcf\x47\x63\xb7\xaa\xd7\x47\xb3\xb2\x58\x57\xc6\x3d\x5a\x2b\x74\x61\x76\xb8\xa8\xe1\x65\xbb\x3f\xb0\x84\x4c\x66\x5b\xae\xcd\x8a\x5b\x59\x4c\x36\xf3\x48\x52\x49\xac\xc3\x59\xed\x3e\x9f\xa9\x68\xa0\xab\xac\x7a\xe5\xd8\x9e\xd7\xd3\x9d\xb5\xd0\x5d\x2a\x75\x5e\x25\x5a\xac\xb8\xc8\xe4\x6d\xc4\x13\x04\x56\xd2\x48\xd5\x3a\x92\xdb\xae\x17\xa7\xe9\x6c\x6e\xd8\xdd\x2e\x96\xb0\x36\xed\x37\x57\x6e\x2b\x95\xd9\x4e\x95\xe4\x68\x23\x20\x34\x5b\x8a\xf3\x96\xba\xb7\x77\x79\x7d\x39\x60\x1b\xb5\x7d\xd9\x76\x0a\x9b\x2d\xad\x95\x56\xdb\x45\x8e\x66\x9c\x2a\x6f\x98\xd5\x4d\x36\xe3\xc1\x61\xdd\xfc\x7e\x36\x2b\xcb\x79\xbc\x88\xb5\x24\x94\x9d\x3b\xf2\x70\x91\x35\xb6\xc6\x8e\x1e\x0b\xfb\x09\x67\xb5\x27\x9c\xb5\x52\x4d\x8f\x26\x11\x96\x8a\x4b\x7d\xbf\xec\x99\xf9\x2d\xcf\x74\x16\xe9\x9c\x33\x76\xab\x73\xb1\xeb\xae\xac\xe5\xaa\xad\xac\xdb\xa3\x56\xa6\x3c\x76\x81\xb1\x74\xf2\x78\x5e\x60\x49\x66\x2d\xf3\x9d\x5e\x26\x57\x8e\xc5\x3a\xee\x9c\x13\x07\x4d\x52\xdf\xe6\x96\xa9\xf2\xb2\xcb\xa2\x11\xef\x94\xf2\x5c\x99\xce\x71\x70\x93\xec\xab\xc3\x7e\x71\xc3\xd6\xc1\x72\x6d\xe5\xfa\x7a\x91\xf0\xdc\x72\xb4\x5c\x32\xac\x5e\x11\x63\x6d\xa6\x3d\x17\x74\x29\xcd\xcd\xd9\x64\x7e\x4c\xcf\x2b\x6e\x79\xca\xcd\x67\x58\x72\xd3\x55\x45\x4f\xc5\x60\xbd\xc1\x5b\x66\x8f\xce\xe0\xa9\x32\x48\xef\x6a\x88\xaf\x75\x0c\xc4\xd2\x9d\x32\x70\x94\xfa\x88\x1d\xe7\xfa\x8c\x9b\x31\xdd\x5e\x4d\xb7\x6b\xe3\x7a\x5f\xd3\x1c\x39\xd7\x4c\x8a\x7c\xbf\x20\x2e\x59\x71\x0c\x3b\x55\x1a\x29\x83\x98\x91\xe3\xf7\x02\x57\xa2\xa5\x7d\xb1\x1c\xcb\x24\xe7\x39\x9b\x03\x9b\x3a\xed\x4c\x4b\x29\x8d\x76\x9a\xfb\x5c\x7f\x3f\x1f\x55\xea\x31\x67\x13\xd3\xb3\x43\x29\xa6\x0d\x74\x27\xdf\x61\x85\xae\xa1\x54\xc7\x4a\x87\xe5\x52\x62\x97\xe7\x93\x19\x15\xe1\x7c\x26\x55\x23\x72\x2d\x36\x8a\x19\x6b\xa3\x24\xad\x72\x7b\x45\x9d\x4d\x68\x05\xb8\xad\x7e\xb3\x5d\xcc\x26\x6d\x94\x32\x98\x1e\x1a\x33\x49\x71\xb5\x4a\x63\xbb\x9a\xcb\x20\x21\x2b\xe5\x84\xec\x50\x14\x92\xbd\x35\x22\x68\xbf\x4f\xad\xb3\x53\x27\x3f\xd6\x61\x76\x5c\xe8\xa1\xfa\x14\x14\x5d\x57\xa2\xe9\x2d\x8b\x0c\x3e\xdd\xa3\x87\xd5\xa5\x33\x34\x17\x31\x9b\xd1\xc5\x71\x7b\x64\x8c\xf7\x65\x45\xa9\xd5\xf3\xc3\x51\x6c\xae\xdb\xdc\xb8\x9c\x9a\x8b\x9c\x04\xb3\xb1\xb9\x2d\x0d\x99\xd2\x5f\xdc\x93\x72\x5d\x3a\x55\xe5\xb8\x9c\xba\x17\x6b\xdb\xd9\x2c\x77\x1d\xcd\xfe\xcc\xc2\x08\x9e\x11\x3e\x33\x3a\xe8\xb7\xcf\x6c\x2f\x1f\x1c\x8f\xc5\xdd\xa9\x15\xa4\xa4\xcf\xaa\x7d\x33\x2f\x72\x6a\x17\x79\xff\x8c\xfd\xd2\xb7\x83\xa5\x77\x2c\xa2\xbe\xbf\xd0\x4a\xfa\x0b\xd0\x3c\x73\xe6\xed\x05\xea\x6f\x5d\x4c\xf9\x85\x2f\x34\xd4\xdf\x2e\x3a\x1b\xe7\x7d\x2f\x2d\xf8\xc0\xde\x3e\x38\x73\xd1\x20\xa1\xdf\xff\x37\x6e\xa8\x9a\x16\x58\xac\x7e\x0e\x7a\xf0\xd5\x35\x81\x41\x79\x9e\x82\xdf\xa6\xe4\x75\xab\x62\x73\x44\x00\xb1\xad\x87\xc7\x77\x6a\x2c\xbf\xc4\x23\xc5\xb7\xda\x5f\xc0\xc1\xeb\x23\x40\x3e\x38\x7d\x09\x02\x64\xeb\xe8\x89\x10\x20\x27\x82\xf4\xb6\x8b\x34\xa8\x03\x01\x1f\xe0\x16\xb9\xa0\x20\xee\x61\xe8\x01\xf4\xac\x7b\x1f\x29\xff\x01\x6e\x89\x87\xd1\x99\xd7\x60\x7c\x6d\x86\xcf\x72\xd7\x42\x07\xeb\x98\xab\x79\x40\x90\x20\x8a\x27\x28\x8e\x6d\xe2\x5f\x9a\x32\x4c\x55\x07\xe6\xce\x2f\xb3\x74\xca\x87\x13\x50\x78\x69\xbb\x96\x21\x01\xaa\x66\x05\x86\xeb\xdb\x54\x85\x2e\x15\x16\x79\xd8\x9e\x38\x73\x97\x43\x58\x50\xc0\x48\xbc\x35\x08\x25\x69\x18\x90\x20\xd7\xfa\xc8\xe3\x77\xeb\xf9\x32\xd5\x6c\xaa\x5a\x2a\xf1\xb3\x17\x4f\xf8\x73\xc2\x92\x3f\xed\x44\x79\x43\xd6\x83\x5b\x0f\x63\xc0\x6b\xf0\xd2\x99\x0a\x6e\x42\x1c\x52\x01\x83\x6b\x11\xde\xbf\x71\x8b\x98\xaa\x01\xc5\xf0\x49\xf1\xdc\x97\x43\x8d\x4e\x5d\x5f\xa6\x78\xf7\xbd\x88\x57\x7e\x84\xe8\x3d\xc4\x35\x9f\x0b\x27\x93\x47\xcc\xb3\x45\x40\x14\xca\x12\xb0\x11\x64\x10\x46\xde\x02\x7c\x5f\x68\xa2\x7c\xd4\x6a\x0a\x34\x1b\x9e\x37\x7a\xa1\xdf\x01\x7b\x35\xe1\x65\xe5\xa0\xf7\x21\xfb\xfb\x88\xc2\x61\x49\x84\xce\xa1\x8a\xa8\x90\xa2\x77\x71\x16\xc2\x05\x16\x60\xf4\x10\xd4\x3f\x9e\xaf\x60\x72\x24\x36\xbc\x4c\x82\x80\x1e\x08\x7d\xf0\x9c\xf0\x9e\x3d\xb9\x27\xe2\xc7\xfd\xfc\x4b\x28\xa7\x1d\x83\x5b\x29\x17\x3d\x2f\x68\x7c\xa7\xea\x85\xf6\x27\xe2\xcf\x08\x49\x90\x44\xeb\x49\xdf\x07\xbe\xb6\x89\x5d\xea\xe6\xb5\x97\xc8\x9d\x18\x18\xd6\xe2\xa9\x73\x56\x9d\xc6\xa0\x2e\x23\x4d\xb7\x43\x4a\x97\x61\x85\x0b\xf8\xb9\x1b\xf0\xcf\xef\xf8\x84\x03\x85\x85\x07\xb7\x38\x9c\xe7\xc3\x98\x67\x5d\x6e\x0e\xfe\x97\xd6\x9f\x55\xdc\xbd\xa7\x4c\xdf\xe1\xf2\x71\x4a\x95\xe4\x31\xef\x39\xb8\x04\x1a\x4f\x05\x9a\x36\xb8\x2a\x72\x7e\xb7\x88\x32\xf8\x38\x17\x79\xf3\xb3\x9e\x29\xfe\x3c\x33\x5b\x49\x9e\x69\xd3\x60\xf7\x0b\x83\xb8\x0d\x3f\x52\x18\xa7\x58\xea\xc5\x5f\xcb\xef\xfd\x4a\x41\x03\x2b\xa1\x41\x24\x13\xe5\x18\x94\x3c\xeb\xa8\x22\x2a\x7c\xb6\xc6\x78\xa4\x84\x17\xd5\x2f\x26\x39\x08\x12\x87\xfc\x3f\xb0\xe2\x7a\xa0\x5f\x2f\x51\xfa\x2d\x08\x31\x9e\x8a\x88\xf5\x07\x3a\xfb\xed\x4f\xcf\xce\x2f\x23\x98\x5f\x47\xe1\x6c\x9f\x3a\xa5\xea\xf6\x9e\x15\xde\xf2\xf8\x57\xb8\xb1\x9c\x73\x88\x8a\xbd\x52\x6c\x3a\x42\x3d\x8b\xaa\xe5\x49\x99\x78\xd5\xe0\xed\xf5\xb3\xa9\xb8\xd8\x84\x4e\xf7\x37\x4d\xf6\x3f\xfc\x7b\xc2\xd4\xe5\x0d\x9d\xc8\x9b\x3f\x40\x07\x9b\xf0\xfd\x82\xc6\xdf\x21\xd5\x7e\xe6\xfe\x3f\x2a\xd0\xe1\xdd\x80\x3f\x22\xcb\x07\xbc\xfe\x21\x09\x3e\x80\xbf\x21\x34\xb7\xa5\xf6\x83\x0e\x9f\xca\xea\xc7\x83\xfd\x7f\x22\x9f\x57\xec\xfd\xcf\x91\xca\xf7\x6d\xec\x9f\x13\xca\x3b\xb2\xe8\x71\xe6\x4a\x10\x2f\x25\xf0\xbd\xd1\xe1\x3c\xe7\x5a\xf6\x4e\x76\xd8\x2b\xc9\xfb\xf5\x6c\x94\x1b\x7a\xf2\x76\xbb\xeb\x43\x9c\xdb\x90\xde\x5e\xe8\x93\xd1\xbf\x24\x43\x27\x44\xdc\x10\xa0\xd3\xda\x83\xf4\xfc\x07\x8a\x8d\x7f\x81\xe7\x13\xe3\xe7\xe2\xf2\xed\xcd\x93\x86\xe0\x22\xd0\x3b\x48\x8f\xa1\x77\x7c\x9a\x9b\x57\x39\x4f\xba\xb6\x83\x9a\x5e\x58\x71\xea\x8c\x72\x6f\x61\x25\xe5\xb7\x4c\x24\x12\x2f\xb4\xc2\xdd\x36\x91\x0e\x57\x43\xef\x1e\x40\x1e\x1a\xc4\x79\x60\x52\xbc\x1c\x57\x91\x84\x4f\x99\x72\xe8\x1f\x1e\x4a\x1d\x9a\xf3\xc0\x0c\x4f\x94\x7c\x13\x15\x61\xf7\x35\xc2\x9c\x96\xe8\x2a\xba\x2c\x01\xdb\xd7\x48\x32\xcd\x30\x17\x5c\xb9\x14\xb0\x3f\x61\x72\xad\x80\x03\x82\xd2\xc3\x6b\x54\x6c\x24\xf8\x17\xca\x0d\x60\x5a\x70\x04\x2d\x4b\xc5\xe8\xc1\x0a\x3e\x1f\x8f\xb7\x49\x35\x48\xfc\xe3\x35\xea\xf5\x58\x44\x1d\xb2\x3d\x9e\xa9\xb0\x79\x22\x2c\x78\x3a\xb9\xeb\x04\x88\xf5\x5e\xef\x3f\xbe\xd7\xfa\x42\xfe\x4c\xfd\xfa\xdb\x79\xd1\xf5\xae\xee\xb5\x09\x9b\x1c\x0e\x98\x24\x6c\x52\x0f\x1e\x56\x5e\x8f\x89\xa9\x79\x6a\xe2\x30\x8c\x0f\xf7\xf1\x04\x51\x0f\xf3\xa0\x34\x61\xd8\x96\xf2\x70\xd6\xf0\xd7\x10\xc2\x6f\xc7\xeb\xe5\x57\x63\x78\x4b\xfe\x72\x80\x6b\x2c\x4f\x47\xf4\x7a\x1d\x92\x00\x4e\x59\x46\xf9\xb0\x9e\xfd\x7f\x9f\x4e\x4a\x8f\xac\x38\x96\x7d\x3f\x7e\xbb\x22\x15\x4b\x9f\x60\xf2\xab\x07\xfe\xb7\xc7\xb3\x71\x43\x6c\xbe\xc0\x86\x1b\x28\x1c\x19\x78\xc3\xe2\xf2\x41\x85\xd0\xaf\x58\xf8\x51\x47\x0b\x9b\xe4\xe1\x01\x3c\x51\xfc\x23\xf5\xfa\x76\x82\xac\x09\x89\x6d\x22\xea\x30\x65\x81\x16\xa4\xe2\x14\x7f\x56\x70\x1c\xea\x38\x68\xd8\xcf\x1b\xf3\xec\xd2\xf4\xd4\xf6\x53\x19\x0d\x8c\x20\x22\x0f\xd1\xfe\x2d\x37\x23\xfa\xf4\xfe\x22\x94\x50\xb5\x3d\x53\xd1\x1f\x3f\x74\x49\xa2\x87\x19\x14\xa1\xa6\xea\x6a\x28\xa9\xd1\x9f\xbe\x45\x9f\xa8\xe8\xf7\xe8\x51\xac\x3d\x84\x1e\x1e\xaf\x09\xbc\x31\x3d\xe1\x16\xf0\x4c\xb1\xe9\xab\x69\xf8\x7e\x80\x67\x98\xd8\xb0\x9e\x4f\xba\xdf\x5b\x35\x05\xd3\x04\xbb\xb3\x19\xf1\x98\xf5\x01\x4f\x8e\x46\xea\xc7\xec\xb8\xb2\x65\xff\xa3\x38\x71\x49\xf8\xa1\xb1\x47\xae\x4d\xa0\x78\xd5\x3e\x24\xe8\xe1\x7c\xc1\x98\xd0\xb2\x35\xe2\xad\xde\xef\x27\xa5\x67\x8b\xd1\x5b\x89\x44\x51\xad\x6b\x8d\xe3\xfd\x51\x25\xea\x21\x70\xa1\xb1\x45\xfc\xc0\x86\x8a\x42\xa8\x97\x4d\x0f\xa3\xfd\x7a\xd6\xfe\xb7\xd3\xc5\xea\x7d\x3d\x4a\x7a\x48\x19\xe5\x9f\x12\x7f\x09\xd4\x85\x16\x0a\x31\x14\x9f\xa9\xdf\x13\x36\x52\x37\x36\x6c\x88\x0f\x51\xaf\xf5\x21\x77\xe9\xf7\xe8\xe3\xd3\x55\x87\x83\x9a\xf2\x3e\x7f\xbb\xa8\xfd\xfe\xc3\xbd\xa7\xef\x67\x5c\xf5\x27\xfc\xf7\x20\x60\x63\x3d\x84\xfc\xf8\xe5\x7a\x8e\x3f\x94\xd7\xd1\xb9\xf9\x7a\x47\x5c\xef\x18\xb9\x7f\xa7\xb4\x9e\xd8\x6d\x7f\x83\xa8\x7e\x48\x73\xed\x60\x7b\xdd\xa1\xf6\xca\x36\xfb\x2a\x9d\x1f\xa2\xf6\xf9\xde\xfc\xe5\xc5\xa6\x83\x35\x2c\x03\x02\x2c\x78\xb5\xd8\xbc\x15\x85\xb0\x08\x2d\x7f\xbd\xfd\x72\x51\x03\x45\xd9\xaf\xf9\xf5\xb7\x5f\x7e\xf8\x73\x6b\xd1\xb7\xe1\x45\xea\x95\xfa\xb7\xf7\xed\xf7\x9f\xbe\x1d\xf3\xb3\xbe\xff\xfb\x7c\x51\xf9\x58\x04\x36\xbf\x78\x6b\xd5\x78\x6b\x26\xa8\xbd\x5c\x1e\xfe\xbb\x04\x9e\x8f\xb9\x30\x97\xd5\xb2\x89\x6d\xe3\x99\x8a\x1a\xfe\x0c\x5e\x54\xfa\xab\xe1\x99\x62\xcf\xd7\xd0\x19\xb5\x27\x0a\x85\x00\xf9\x8a\xc2\x13\x76\x10\x20\x7b\xdc\xf8\xa0\x69\xc0\x56\x02\xe4\x80\x27\x04\xc8\xbf\xff\xf4\x8d\x00\x39\xa1\x00\x4b\xb9\xe4\xc8\x61\xe8\xff\x7a\x08\x3a\xa8\x28\x60\xd2\xe3\x2d\xb8\x07\x06\xfa\x4d\x6f\x6b\x9d\x03\x17\xfd\x26\xd7\x3a\xe6\x84\x95\x87\x93\x90\xdb\x8d\x0e\x0c\x25\x40\xbe\xe2\x67\xf0\xe7\xc0\xd5\x5b\xb5\xdf\xaf\x89\xbc\xa3\x4f\x2f\x89\x0a\x63\xcd\xb1\x57\x8a\xbb\x01\xe3\xaa\xc4\x17\xde\x40\x87\xdf\x82\x2c\x99\x58\x3f\x4a\x14\x45\x70\xc8\x97\x6b\xc0\x8f\x97\x83\x5d\x2a\xdc\xdb\xb2\x02\x44\xd1\xfc\x48\x58\xbc\xfa\xa3\xb4\xdc\x69\x1c\x88\x8b\x57\x19\xc8\x8b\xf7\xed\xf7\x9f\xbe\x79\x1f\xf7\x85\x25\x6c\xfe\x25\x69\x09\xda\x7e\x2c\x2e\x41\x9b\x0f\xe5\xc5\x6b\xf2\xb1\xac\x78\x2d\x3e\x11\x96\xbf\x49\x56\x42\x92\x4e\x84\xe5\x9f\x90\x95\x60\x94\x3f\x21\x2c\xa7\xdf\x6f\xa9\xd4\x83\xf3\x72\xaa\x55\x3f\x76\x79\xa8\x70\xe6\xcf\x1d\x8d\xd0\x78\x7f\x79\xa5\xd8\x6b\x01\x10\x30\x22\x2a\xb2\xe1\x2f\x1f\x49\xf2\x21\x9c\xe7\x4b\xde\xc1\x38\xf9\xe9\xdb\x61\x98\xfb\x3a\xfc\xd8\xf1\x9e\x1a\x3f\x36\xb8\xa3\xc9\xa3\x21\xc1\x57\x02\x73\x90\xa6\xf7\x8c\xef\xbb\x0a\x9d\x8a\xdd\xe1\xc8\xff\x50\xdc\xe3\x87\xda\xde\x9f\x8a\xc3\xce\x76\x06\xe2\x9a\x91\x1f\xca\x4d\x20\x35\x37\x36\xbe\x40\x84\x8e\x5c\xb8\x94\x98\x4b\x6b\xf3\x5c\x66\xae\x6d\xba\x5f\x11\x74\x29\x47\xb5\x12\xde\x1e\x3f\x82\xe4\xe1\x68\xe4\x85\x0a\xe0\x89\xba\x6c\xe1\xe3\xfd\x78\xed\xf4\x1e\xcd\x08\x1d\xdb\xc8\xb7\x22\x8e\x71\x8a\x33\xc3\xc1\x17\xcd\x9f\x10\xdc\x92\xb1\x2a\xac\x1f\x1e\x2e\x1c\x49\x8a\xfa\xe9\x21\xfa\x63\x70\x1e\x1e\x7d\x4c\x28\xaa\x08\x1f\xce\xa8\xf2\xaa\x6f\x04\x91\xa2\x8f\xfe\x9b\xbb\xce\xdb\x1e\x42\x20\x9e\xf5\x42\xbd\x06\x43\x9f\x5a\x34\xb7\xda\x5e\x09\x9e\xcf\x89\xe7\x23\x9c\x5f\x99\xdf\xce\x05\xc7\x67\xc8\x49\x3d\xfb\xdb\x1d\x3b\xda\x37\x7b\x0e\x6f\x33\x7b\x7d\x27\xe4\x10\x86\x8a\x3e\x9e\x89\x93\x6f\x5f\x05\x37\x32\xa8\xd7\xe3\x34\x74\x83\x92\x87\x63\xef\xe8\xa3\x87\x91\x3f\xfc\xd3\x05\xe6\x1a\xd8\x61\x9b\x3c\x5f\x2f\x24\xdd\x30\xb1\x03\xc5\x76\x58\xef\x5f\x5e\x38\x27\xea\xfb\xd3\x2d\x1e\x5c\x02\xb2\x14\x60\x78\x76\xac\x88\x49\xf4\xc3\xfe\x21\x8f\xae\x95\x89\xff\x02\xb9\x6f\x87\x17\xe8\x7a\x96\x01\x8e\x5e\x76\xa6\x28\x4b\xc7\x98\x28\x5f\x41\xd4\x50\x76\x96\x2a\xdc\x18\x0a\x22\x3f\x6a\x7b\x13\x86\xbf\x70\x05\x58\x20\x1a\xb0\x92\x45\x60\x9d\x9b\xc0\x47\x24\x0c\x53\x45\x72\xdb\x57\x05\xcf\x54\x92\x63\xae\xf7\xa4\xa0\x49\x09\x23\x8b\x00\x44\x9e\x29\x26\xc1\xe6\x2e\x97\xe8\x65\x2f\x1d\x6c\xa7\x50\xc3\x82\x4a\x76\xcf\x14\x9b\xca\x5c\xd1\x8e\x35\x07\x9a\xcf\x54\xf4\x12\xc7\x2b\xfd\x45\x54\x1d\x5a\x04\x1a\xde\xb8\x5c\xfa\x0a\x0e\x01\xbc\xaa\xa9\xfb\xf0\x3d\xc4\xd7\xf4\x1d\x39\x44\x4c\xfb\x8a\x41\x14\xe5\xf9\x22\x7e\x5f\xeb\x99\x4a\xa6\x99\x1b\xd4\xdb\x86\x08\x08\x6c\x84\x77\x62\xbc\x56\x1f\xd3\x7e\xf1\xe8\x6b\xe8\x1b\x33\x17\x58\xdf\xb7\x30\x0e\xc5\x27\xfa\x63\x32\x07\xb2\xa9\x74\xf4\x33\x56\xfb\x66\xe7\x87\x80\x18\x26\xcb\x4b\xd2\xe7\x80\x7c\x9b\xe4\x43\x48\x6c\x16\x24\xf9\xdc\xe7\x90\x4e\xf6\xa3\x0f\xe1\x49\x92\xc0\x32\xd9\x2b\x78\xf7\xd5\xfd\xb9\x32\x09\x15\x49\x02\xa3\x87\xe8\x99\x24\x1c\x95\xcf\x93\xb7\x73\x99\x40\xb7\xae\x14\x72\xa8\xb9\xa0\x29\x40\x44\xbc\xcd\xed\xf5\xd0\x34\xf1\x2e\x14\x14\x4d\x85\x65\x04\x13\xa0\x3d\x52\xff\x43\xb1\x0c\x73\xbe\x1d\x1d\x94\x5f\x02\x10\x62\x3e\x44\xcf\x22\xec\xd1\x27\xea\x0a\xe6\x63\x42\xb0\xac\x87\xa8\x7f\xd1\x3b\xfa\x44\xfd\xfb\xa7\x6f\xef\x48\x7c\xff\xf9\xdf\x67\xaa\xfe\x2e\xbd\x02\xbc\xa0\xb8\x71\x84\x5f\xc6\xc8\x73\xcc\xaf\xb7\xa0\x4f\x51\xf5\x16\xc0\x05\x76\x51\x96\x61\x7e\x8e\x5e\x6c\xc0\xf7\x37\xab\xeb\x8d\xed\x0e\x05\x07\xdc\xe1\x83\x3f\xe8\x49\xd4\xe5\x3d\x72\xfb\x1e\x34\xb0\x88\x89\x77\x7f\xd7\xe6\x7b\xb9\xa1\x7e\xbf\x88\x15\xdf\x8b\x7a\x74\x31\xa9\x62\x1b\x89\x77\x03\x1f\x91\x17\x85\x7d\xeb\x61\x6c\x58\x09\xaa\x8c\x51\x94\x50\x6b\x84\x5d\xca\x55\xa0\x09\x29\xa2\x00\x42\xa9\x56\xe2\x85\x56\xd8\xb7\xc8\x87\x03\x9d\x9d\x0a\x7f\x10\xff\xbc\xbc\x10\xf8\xa7\xa3\x2c\x9e\x09\x3a\x22\x9e\x92\xbf\x3a\x12\x39\x8b\xbc\x7c\x1e\xc0\x3c\x5c\x75\xbb\x8a\x60\x86\xb1\x36\x41\xb1\xd1\xfa\xe1\x3d\x3a\xf2\x44\x71\x7f\x38\xe2\x76\x4c\x38\xba\xc3\x9a\xcb\x1b\x48\x7f\x29\xf8\xf4\x4c\xf5\xf8\x15\x14\xc8\x95\x39\x08\x89\x82\xc5\xb3\xe6\x37\x93\x3b\xaf\x62\x4b\x41\x8e\x67\x09\x8b\x9e\xc2\xf1\x8f\xba\x1a\x88\x3c\xd0\xff\xcf\xc3\xff\x8a\xb1\xc7\xff\xb5\xe8\x04\xdc\x42\xe1\x9d\x43\x61\x4e\xa8\x67\x0d\x9d\x2d\x2b\xcf\xbf\x39\x01\xf5\x46\xa5\xf2\xf9\x4b\x6b\x3c\xe4\x7a\x98\xdd\x29\x02\x24\x43\x33\x7a\xb6\x36\x03\xd7\xf1\x0a\x16\xf7\x19\x2c\x17\x98\x48\x45\xf2\x97\x80\x25\x3f\x03\xa6\x22\x09\x7f\x09\x12\xfb\x19\x24\xcb\x16\x04\x4f\xe9\xdf\x00\xf6\x61\xb7\x43\x3e\xe8\x79\xc7\x77\xb1\x7c\x5f\x15\xe7\x17\xbd\x1e\xa0\x03\xd1\x45\x08\xfd\xa7\xa0\x30\x11\xe4\x8a\x06\xda\xf4\x1b\x15\x3d\xbe\xc7\x3e\xea\x79\x6b\x02\xd0\xe0\x43\xf2\x31\x7a\xe6\xda\x9c\x0c\x73\x79\xa3\xec\xaf\x0d\xc4\xde\x1f\xe8\xc6\xc5\xb4\x5b\x63\xf9\x7e\xf8\xf1\x1d\xd6\xaf\xd7\x63\x6b\xd8\x82\x16\x79\x88\xde\xff\x85\x81\xe8\x85\xbb\xf3\x31\xf2\xf1\xe0\xce\x74\xf4\x99\x7a\x08\x5b\x7a\x80\xe7\x54\xfc\x1d\x8d\x04\x96\x24\x0b\x92\x87\xc7\x84\x06\x25\xf2\x48\xd1\x27\x55\xfe\xee\xf5\xf0\x18\x6e\xd7\x54\x8c\x8a\xfe\xec\xe7\x5f\x9f\x02\x5b\xdc\x06\x46\xb0\x71\x0e\x2b\x78\x51\xcb\x39\xb0\xbb\xfc\xbc\x71\xa7\xee\x16\x3f\x43\x2c\x4c\xff\xb3\x0c\x25\x60\x6b\xe4\xda\xc7\xd3\xbd\xee\x07\x2d\xe6\x73\x3d\x72\xf9\xd6\xe5\xc8\x59\xa7\xb3\x0e\x09\x49\x45\xe2\x43\x34\xe1\x17\x06\xf9\xef\xd1\x47\x3f\x88\x79\xa2\x5d\x6c\x53\xfb\x1c\xc2\xc9\x74\x6a\x2a\x5a\x47\x1f\x43\xf3\x41\x31\xa1\x14\x7d\xa2\x4e\x74\xd5\xd9\xf5\xc4\xcf\x01\x5f\x08\xcb\x11\xb0\x65\x0a\x1f\xc1\x3d\x58\x2f\x1a\x39\x6b\xf5\x31\x2d\xfe\xd3\x43\xd4\xdb\xfc\xa3\xf7\xe7\xee\x34\xa7\xfc\xef\x9d\x38\xf1\x34\x5b\xfd\xaa\x87\xe9\x9f\x2a\x1c\x36\x3a\x55\x83\x0f\xd1\xaf\xe4\xc5\x7e\x9c\x12\x7b\xbe\xe4\x3c\x57\x7b\x6a\xc3\x8b\xb0\x8c\x7f\xa7\xf3\xca\x42\x0f\xe1\x3c\x9f\x70\x37\x2c\xfa\xc8\xd5\x31\x21\xf2\xdf\x3c\x6f\x42\x2b\x11\x7c\x3f\xaf\xf7\x94\xb9\x2a\x0c\xfd\x9a\xaa\xe7\x70\x79\x0d\x2f\x0a\xcf\x2c\xc7\xc4\x4f\x7e\xd4\xe5\x21\x7a\xc6\xbd\x5b\xbf\x0a\x10\xfd\xbf\x58\x07\x8e\x6a\xa9\x24\x48\xa3\x0e\x52\x98\xee\xaf\x84\x2f\xc2\x83\x6e\xdc\x04\xee\x91\x94\xcf\xa0\x86\xed\xbe\xb6\xb8\x8e\xd0\x4d\x68\x19\x18\x59\x9f\x23\xcd\x63\x71\xf7\x09\xec\x7b\xab\xe8\xeb\x86\xdb\xf9\xb4\xdd\x37\x6e\x6f\x5d\x7f\xf8\xd3\x96\xdc\x51\x9e\x6f\x9e\x10\xde\xb0\xe5\x6e\x5f\x21\x38\x5b\x27\x9e\x79\x12\xa6\xfc\xab\x48\x30\x21\xb0\xa0\x35\x82\x82\xed\x39\xbd\xf7\xcc\x94\xf0\x2a\xc6\x7d\x33\xe5\x04\xa8\x08\xff\x10\xd0\x9b\x26\xd9\xb5\x09\x1e\x8d\xfe\xd1\x59\x3b\xc9\x2e\xfc\xf4\x70\xfb\x1f\x31\xb9\x43\xec\x02\xe4\x04\x8c\x2c\x72\x48\x3a\xa2\x5e\xa9\x6f\xdf\x12\xdf\xc3\x43\x91\xa0\x2a\x0c\x76\xfe\x9e\x80\x5b\x02\x91\xf8\x70\x33\x9b\xec\x89\xfa\x46\x09\xb6\x69\x42\x44\xfc\x37\x03\x3d\x53\xae\x8a\x44\xec\x26\x34\x2c\xf8\x4e\xb4\x7f\xfc\x78\xdc\xe4\x03\xc8\xc1\x6b\x70\xc2\xa0\xe5\xd4\x86\xc1\x3b\x85\x8e\xfa\xd4\xaf\xf6\xc8\x3c\x12\xf3\x8d\x32\x00\x51\x9e\xa9\x28\x1d\x7d\xf2\x2c\x2d\x60\x79\xdf\x8d\xeb\xf7\xb3\x47\x9f\xa8\x23\xc3\x9f\xbf\x96\x24\xf4\xf8\x74\x64\xde\xdd\xe3\xf0\x0f\x52\x9e\xa8\xef\xa7\xca\xfb\x1d\x51\xe3\xec\x45\xef\x5f\xc1\xeb\x3d\x51\xe7\x12\xa5\x53\x0c\x3e\x19\x30\x90\xa0\x0f\x87\xbb\xcc\xb3\xf8\x0b\xa3\x05\x01\xe6\x8f\x06\x7b\x4f\x70\xf8\x70\x98\xa7\xbf\x9f\xf5\x7e\x62\xe2\xc7\x8c\xf0\x5a\xfc\x43\xb8\x3d\x1d\xf2\x24\xfd\x36\xfe\xf7\x3b\xe8\xfe\xcf\x87\x38\x9e\x85\x4a\x1e\x8f\x8a\xe6\xb7\xb3\xa5\xec\x00\x93\x02\x86\xf1\xbe\xa0\x8e\x4b\xc9\x3f\xf2\xfa\x11\x18\x46\xf4\x34\x01\x26\xc0\xea\x8b\x9a\x25\x58\xac\xcf\xe1\xe7\x51\xc1\x5d\xe6\xa5\x9e\x64\xd5\xfa\x5b\x1b\x25\x01\x11\x46\x28\x02\x78\xff\xb5\x3c\xaf\x91\x38\x7b\x48\xa3\x15\x55\xa0\x61\xf9\xd6\x3b\x59\x82\x34\xf6\x0b\xc3\xfc\x3a\x1b\x39\x30\x40\x02\x30\xc1\xb6\x1a\xdf\x6a\x37\x73\x92\x83\xca\xf0\x17\x26\xef\x5c\xd4\x0a\xda\x04\x7b\xc5\x79\xa6\xf0\xfb\xcd\xd7\x13\x93\x27\x72\x71\xc5\xf5\x3d\x2b\xfc\xfc\x87\x4c\x8e\x57\xe8\xf0\xf1\xf7\x4b\x44\xd5\xd2\xd5\x23\xb8\xf3\x9f\x20\x29\xf9\xed\x6e\xbd\x8d\xe6\xc6\xab\x6b\xfe\xdb\x0f\xe5\xff\x72\xeb\x9d\x34\xa7\x29\xe1\xd4\xc7\x37\xc8\x02\xa2\x2e\x2e\x0f\x9f\x5c\x2d\xbd\x7b\x15\xf6\xc2\x8d\x09\x7e\x29\xe0\xce\xdb\x60\x22\xc1\x1b\x4f\x22\xc1\x3b\x3c\x23\x14\xfd\xf6\xe1\x7b\x73\xae\xd0\xbb\xba\xf9\xfa\x09\xbf\x0f\x09\xf5\xc7\x50\xc4\x6d\xde\xbf\xf9\xfc\xfe\x84\x5d\xb7\xb3\xb1\x0f\xaf\x78\xfa\x1b\x45\xfe\xcc\xa5\xf9\xff\xe5\xfd\xff\x58\xde\x15\xee\x6d\x18\x5a\xf6\x54\x68\x2c\x3f\x9f\x5f\x2a\xb8\xbc\x28\x7c\x6d\x7f\x7b\x5c\xba\x7f\x7d\xf2\xeb\xa2\xfd\xe9\xda\xbb\xbc\x30\x72\xe5\x4a\xdd\xb9\x4c\xfd\x67\xa1\xdf\x74\xac\xc2\x4b\xe2\x43\xe0\x1e\x18\xf6\xf7\x8d\x74\xe1\x64\x9d\x0c\x75\x98\xa4\xcb\xb1\xfe\x03\xd4\xc1\x0b\x1d\x5c\x44\xfe\xe1\x85\x0e\x7e\x3b\xfa\xff\x0d\x00\x00\xff\xff\x2c\xca\xe7\xea\x4c\x7a\x00\x00")
+var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x77\x97\xe2\x48\xf2\x28\xfa\x7f\x7f\x8a\x5c\x66\x66\xa9\xfa\x51\x20\x84\xb0\xd5\x55\x75\x16\xef\xbd\x67\xee\xbc\x59\x99\x94\x01\x39\xa4\x94\x04\xf4\xed\xef\xfe\x8e\x1c\x08\x61\xaa\xba\x7a\x66\xef\xbe\x7b\x5e\xcf\x74\x03\x69\x22\x23\x22\x43\x91\x91\x11\x91\xa9\x97\x7f\x30\x0a\x8d\xf6\x2a\x04\x3c\x92\xc4\xb7\x2f\x2f\xf6\x07\x10\x49\x99\x7b\x8d\x40\x39\xf2\xf6\xe5\xcb\x0b\x0f\x49\xe6\xed\x0b\x00\x2f\x12\x44\x24\xa0\x79\x52\xd3\x21\x7a\x8d\x18\x88\x8d\xe7\x23\xa7\x0a\x99\x94\xe0\x6b\xc4\x14\xa0\xa5\x2a\x1a\x8a\x00\x5a\x91\x11\x94\xd1\x6b\xc4\x12\x18\xc4\xbf\x32\xd0\x14\x68\x18\x77\x7e\x3c\x01\x41\x16\x90\x40\x8a\x71\x9d\x26\x45\xf8\x8a\x3f\x01\x9d\xd7\x04\x79\x13\x47\x4a\x9c\x15\xd0\xab\xac\x5c\x00\x66\xa0\x4e\x6b\x82\x8a\x04\x45\x0e\xc0\x2e\x6e\x0d\x12\x29\x32\x04\x23\xe8\x8c\x1a\xee\x45\x1a\x88\x57\xb4\x40\x87\xae\x40\xf3\x24\x14\x41\x03\xca\x9a\xb0\xd1\xa1\x0c\x1e\x78\x84\x54\xfd\x19\xc3\x90\x25\x20\xa8\x25\x68\x45\xc2\x24\x81\xe6\xfd\x06\x8f\x17\x40\x39\x28\x43\x8d\x44\x8a\x76\x0d\x11\xf3\xdb\xb7\xc4\x0c\x6a\xba\xa0\xc8\xdf\xbf\x5f\x74\xd5\x14\x4a\x41\x7a\xa0\x9f\xac\x08\x32\x03\x77\x4f\x40\x56\x58\x45\x14\x15\xcb\xed\x82\x04\x24\xc2\xb7\x10\x75\x2f\x98\x5b\x6c\x37\x10\x05\x79\x03\x34\x28\xbe\x46\x74\xb4\x17\xa1\xce\x43\x88\x22\x80\xd7\x20\xfb\x1a\xf1\x09\xd2\x11\x49\x6f\x54\x12\xf1\x09\x4a\x51\x90\x8e\x34\x52\xa5\x19\xd9\x21\xf0\x58\x80\xa5\x13\x44\x02\xc7\x68\x5d\x3f\x95\x25\x24\x41\x4e\xd0\xba\x1e\xf9\x02\x00\x00\x82\x8c\x20\xa7\x09\x68\xff\x1a\xd1\x79\x92\xc8\xa7\xe3\x1c\xd7\xdf\x8f\x92\xc2\xa2\x4c\x75\x87\x26\xb1\x10\x54\x89\x24\xd2\xdd\x4a\x8c\x69\x60\x38\x3b\xcc\xe5\xd3\xd8\x3a\x4b\x2f\x31\xa1\x35\x19\x4e\xfb\x3c\x3d\xd7\x72\xbb\x42\xcb\x54\x46\xbb\x49\xaa\xbb\xb2\xf0\x49\x04\xd0\x9a\xa2\xeb\x8a\x26\x70\x82\xfc\x1a\x21\x65\x45\xde\x4b\x8a\xa1\x47\x3e\x4c\x99\x4d\xc6\x5a\x67\xa0\x28\x98\x5a\x42\x86\x08\x93\x55\x09\x33\x05\x7d\xad\xc7\x65\x88\x2c\x45\xdb\xfc\x2b\x9d\x48\xa5\x13\x39\x8c\x11\x74\x64\xd7\xbc\x47\x13\x6f\x66\xc7\x93\x62\xdd\xd8\xa4\xb7\x13\x4b\xd2\xf6\x35\x6a\xb5\x9a\xc8\xc4\x50\xab\x8f\xf6\xab\x39\xae\x2b\xe5\x42\x1b\xab\xec\xb3\xf9\x83\x9e\xd7\x0d\xaa\x54\xeb\x4f\xb3\x05\xc4\x61\xf5\xfa\x8a\xdd\x34\x4b\xd4\x7d\x9a\x1c\x4a\x80\xfd\x98\xbd\x46\x10\xdc\x21\x9b\xdf\x4e\x0d\x00\xac\xa2\x20\xa8\x81\x6f\xce\x0f\x00\x28\x45\x63\xa0\x16\x47\x8a\xfa\x0c\x70\x75\x07\x74\x45\x14\x18\xa0\x71\x14\xf9\x90\x7c\x02\xee\xff\x09\x3c\x95\x79\xfc\xea\x75\x90\x48\x8d\x13\x64\xb7\x43\x26\xa9\xee\xfc\x72\x95\x64\x18\x41\xe6\xce\x0b\xed\xb1\xe3\xa4\x28\x70\xf2\x33\xa0\xa1\x8c\xa0\xe6\xd7\xb0\x8a\x8c\xe2\xba\x70\x80\xcf\x00\x4f\x9d\x3a\xd0\x8a\xa8\x68\xcf\xf6\xf8\x0f\xd9\xfc\x13\x70\xff\x7a\x63\x7f\xff\x12\x24\x80\x04\xdf\xce\xfb\x08\x32\x0f\x35\x01\x81\x7f\x08\x92\x2d\xbc\xa4\x8c\xce\xb0\x60\x20\xad\x68\xa4\xfd\x38\x3f\x03\x43\x66\xa0\x26\x0a\x32\x3c\x03\x9c\xa0\x49\x4d\x31\x74\x28\x82\x6f\xe7\xb4\x52\x0a\x42\x8a\x14\xa4\x2c\xdc\x23\x2e\x20\x28\x85\x11\xfa\x85\xc8\x13\x4c\x1a\x7f\x8f\x17\xd7\x61\x25\x54\x92\x83\x71\x9a\xd4\x98\x23\x58\x47\x95\x3d\x03\x22\x79\x83\xc1\x22\x64\xd1\xf9\x2c\x3d\x83\x54\x46\xdd\x01\x3c\xa9\xee\x40\xc6\xff\xe6\x37\x61\x04\x5d\x15\xc9\xbd\xcd\x38\x9b\x15\x71\x4a\x54\xe8\xcd\x39\x4a\xba\x20\x73\x22\x8c\xbb\xa8\x28\x32\x22\x05\x19\x6a\x01\xd4\x9e\xde\x6f\x66\x2b\x73\xa8\xe9\x71\x44\x52\x22\x0c\x31\xf6\x19\xd8\x88\x39\xc8\x79\x5f\xce\x87\x77\x00\xe8\xb4\x06\xa1\xac\xf3\x0a\x0a\xc0\xf6\xe1\xa8\x8a\x2e\xb8\x53\xaa\x41\x91\x44\x82\x09\x7d\xea\x14\x13\x6a\xac\xa8\x58\xcf\x80\x17\x18\x06\xca\x5f\xcf\xe5\xdd\x9f\xd2\x0f\x88\xfc\x0d\x6c\x8e\x38\x20\x8d\x94\x7d\x2c\x9c\xef\xac\xa2\x49\x20\x91\xd1\x01\x24\x75\x18\x57\x8c\xe3\xa4\xd0\x86\xa6\xdb\x82\x71\x50\x14\x29\x2e\xc8\x5f\xcf\xe7\x15\x4f\x26\x7f\xbb\x21\x11\x36\xe1\x9a\x22\xc6\x55\x0d\x9a\x4f\x37\xea\x64\xb8\x43\x61\x51\xc9\x7c\x04\x60\x5c\xa0\x15\xf9\xa4\x0f\x48\x7a\xc3\x69\x8a\x21\x33\x71\x41\x22\x39\xf8\x0c\x0c\x4d\x7c\x88\x30\x24\x22\x9f\x9d\x02\x4c\x37\xb9\xd8\x4e\x12\x9f\x7e\x23\x68\xdd\xe4\xc0\x4e\x12\x65\xfd\x35\x6a\x6b\xca\x67\x0c\xb3\x2c\x2b\x61\x11\x09\x45\xe3\xb0\x54\x32\x99\xb4\x1b\x47\x01\x2b\x88\xe2\x6b\xf4\xb7\x14\x91\xa5\x73\x99\x1c\x13\x05\xf6\xa2\x5d\x52\x76\xaf\xd1\x24\x48\x82\x3c\xc8\x47\x7f\x23\xe0\x6f\x04\x6d\x2f\x1d\x80\x79\x8d\x76\x33\x89\x54\x06\x24\xc5\x78\x1a\xb8\xff\xe1\x89\x4c\xdc\xfe\x9b\x72\xff\x02\xef\x33\xee\x95\x1f\xa2\x98\x0b\xc0\x1e\xee\x37\x02\x46\x1e\xdf\x21\xdb\xe6\xd5\x7f\x21\xd9\xa9\x44\xce\x21\x1b\x4f\x64\x00\xee\x92\x09\x02\x24\x03\xbf\x3c\x1d\x77\xfe\xfb\x30\xd9\x82\xcc\x08\xb4\x6d\x3f\xe8\x40\x14\xae\x91\xec\x2b\x2c\x17\xd1\x73\x28\x14\xc9\x70\xe1\x07\x37\xae\x09\x1c\x8f\x9e\x41\xe6\xea\x13\x7b\xfd\x91\xbf\x29\xe5\x57\xfa\xa0\x93\xd2\x73\xd6\x09\x96\x94\x04\x71\xff\x0c\x8a\xfe\x2a\x07\x06\x9a\xf2\x04\xca\x8a\xac\x2b\x22\xa9\x3f\x81\x2e\x94\x45\xe5\x09\x74\x15\x99\xa4\x95\x27\xd0\x31\x68\x81\x21\xbd\x7a\xf8\x04\x3a\x02\x05\x5d\xdd\x6f\x37\x51\x9e\x40\x05\xae\xc9\x99\x01\xc6\xa4\xac\x7b\x25\x25\xc1\xb6\x45\x20\x29\x81\x19\xd4\xc8\x60\x4d\x59\x31\x34\x01\x6a\xa0\x07\xad\x27\x20\x29\xb2\xa2\xab\x24\x0d\x9f\x80\x0e\x35\x81\xbd\x42\x8a\x06\x19\x41\x83\x34\x3a\x11\xf3\x14\xa8\xa5\xa1\x86\x04\xd6\x9e\x0e\xf8\x7f\x03\xb1\xe1\x79\x4b\xb8\x05\x71\x93\x14\x0d\xf8\x74\x97\x2b\x37\x9b\x5e\x63\xd1\x59\xe3\x93\x4c\x29\x1a\x13\xa7\x34\x48\x6e\x9e\x81\xf3\x11\x27\x45\xf1\x0a\x96\xb2\x82\xa0\x1e\x17\x05\x1d\x81\x04\x29\x42\xed\xa4\x28\x8f\x56\x4b\x82\xc8\x65\x34\x7b\xdd\x75\x3e\xbe\x5e\x37\x00\x12\xc1\xba\xcb\xc1\xed\x92\xd0\x23\xc8\x93\x32\x07\xf5\xe0\xaa\xe8\x16\x31\xce\x6a\xf9\xff\xb9\xb9\xff\x10\xcb\x75\xa8\xd9\x5b\xaf\x20\xd5\x88\xf1\x4b\xe3\x14\x29\x07\x57\xf0\xff\x8b\xc8\xbe\x6a\x05\x7d\xfb\xb4\xdd\xf1\x01\xf3\x93\xd3\x48\x95\xff\x21\xb3\xe8\x42\x0b\x03\xc0\x43\x57\x99\xe7\x82\x76\x65\xd0\xca\x4f\x05\xca\x5d\x32\x7e\xc8\x6e\x72\x90\xbc\x82\x1a\x49\xe9\x8a\x68\xa0\x23\x6a\xce\x58\x49\xff\x97\x6d\xcc\x06\x7e\xde\xc1\xfb\x72\x45\x71\xd9\x22\x2a\xa4\xfd\x68\xc7\x6d\x4b\x50\x24\xf7\xff\x11\x0c\x00\x38\xc4\x9d\xfd\xf5\x33\x28\x14\x0a\x85\xaf\xb7\x97\x5a\xd6\xf9\xf3\xfe\x3e\xc9\x53\x50\xde\x4c\x64\x3e\x44\x69\x42\xd5\x14\x4e\x83\xba\x1e\x5e\xb6\x5d\x92\x48\x03\x29\x5f\xaf\xae\xe7\xc1\x1a\xdf\x84\xbc\x24\x97\xb8\x58\xf6\x75\x5e\xb1\xe2\x92\xa2\xc1\x38\x65\x20\x14\x30\xab\x6e\x6d\x16\xdf\x93\xec\x5f\x4e\x76\x76\x57\x61\x48\xf1\xb6\xf5\x7d\x65\x5a\x7c\x33\x5b\x55\x84\xe0\x2e\x0b\x80\x17\xcc\xd9\x17\xbf\x7d\x79\xc1\x5c\x1f\xd3\x97\x17\x4a\x61\xf6\xce\x8e\x59\x26\x4d\x40\x8b\xa4\xae\xbf\x46\x64\xd2\xa4\x48\x0d\xb8\x1f\x71\xb8\x53\x49\x99\x89\x4b\x8c\x5f\xc0\x90\xda\x06\x50\x9c\xf3\xe9\xed\xa9\x5f\xc8\xf3\xbe\x71\x4a\x23\x65\xc6\x77\x22\xfc\x12\x79\x2b\x0e\xa7\xc5\x49\xbf\x57\x7d\xc1\x48\xaf\x87\xc7\xa8\xf3\x6e\x48\xe1\x38\x11\x6a\x11\x6f\xe7\xee\xb6\x89\x00\xdb\x0a\xf5\xea\x5e\x23\xb4\x22\x8a\xa4\xaa\x43\xbf\x98\xd4\x38\xdb\x3b\xf6\x8b\x0b\xa2\x0b\x65\x23\xe2\xf1\x81\xd4\x04\xd2\x37\x79\xf5\xf3\x16\x6e\x9d\x4b\x1a\x64\x5e\x23\x2c\x29\xea\xd0\x2b\x15\x49\xca\x76\x86\x4c\x9c\xf1\x6c\xa2\x05\xce\x51\xad\x1e\xad\x00\xbc\xe8\x2a\x79\x03\x73\xc7\xa8\x8e\xbc\xbd\x60\x76\x13\x8f\x52\xcc\x25\xe3\xcd\x9d\xd9\x17\x46\x38\x32\xda\x27\xc5\xe7\xec\x89\x34\x81\x79\x8d\x04\xd0\x3d\x8e\x6c\x88\xa1\x71\xed\x69\x93\xb4\xb8\x2d\xb8\xc7\x56\x8e\x4f\x27\xd0\xce\xdd\x50\x33\x9a\xa2\x32\x8a\x25\x07\x9a\x85\x26\x2e\xee\x78\x82\xfc\x76\x1e\x49\xa7\x49\x74\x90\xb2\xc5\x50\xaf\xf8\xa0\x80\xa6\x88\xb7\xe6\xe9\x38\x5e\x60\x38\x6f\x4e\x78\x52\x57\x15\xd5\x50\x5f\x23\x48\x33\xe0\x8d\xc9\x78\x3b\xeb\x37\xb0\xc7\x0d\x22\xee\x0b\x12\x00\x61\xae\x1e\x09\x90\x4e\x33\xed\xcc\xa9\x08\x19\x6a\x1f\x26\xe1\x7c\x98\x17\xf2\x02\x8a\xcd\xbc\x23\x13\x30\xa7\x33\x46\xed\xe3\xba\x20\x09\x22\x69\xbb\xb4\x22\x6f\xa5\x3d\x18\x1f\x7f\x86\x30\xfb\x11\x98\xbc\xa2\x23\xdd\x01\xd7\xb0\xbf\x7d\x16\x92\xbb\x10\x47\xde\xc6\xce\xa7\xcb\xba\x30\xbf\x30\x46\x30\x4f\x05\x2f\x98\x28\xdc\x95\x9e\x77\x84\x26\x8c\x81\xa3\x96\x23\x6f\x75\xfb\xe3\x6c\xe4\x77\x07\x02\x4c\x5c\x56\x64\xef\x09\xf0\xac\xc7\x1e\x69\x36\x7f\x04\x07\xaf\x5b\xe4\xad\xec\x7e\xf9\x09\x0c\x7c\x53\xee\x47\x51\xf0\xfb\x45\xde\xc6\xde\xb7\x9b\x48\xbc\x60\x86\xf8\xf6\xe5\x6c\x52\x5e\x30\x99\x34\x1d\x7d\xf1\x22\x91\x82\xec\x3d\x65\xf6\xd7\xc8\x49\x75\x78\x36\x8f\x8b\x27\xa9\xaa\xbe\x2a\xd6\x14\x03\xd9\x1b\x05\x01\x5a\x6f\x2f\x58\xf0\x97\x03\xd9\x86\xe2\x82\xf6\xfc\x88\x76\x77\xf7\xab\x0f\x41\xf5\x07\x71\x56\x65\xc9\x40\x90\x39\x69\xf0\x73\x7f\x3b\xf8\xa7\x24\x30\x8c\x82\xbe\x02\x89\x64\x20\xb0\x04\xc4\xbb\xea\xf1\x48\xaa\xb3\xe2\x38\xaa\x4e\xd1\x9e\x35\xc8\x7c\x75\x0c\x5e\xcb\x5d\x4a\x29\x45\x64\x22\x6f\xff\xfc\x25\x9b\xc9\x10\xc4\x57\x4f\x6b\x02\x6a\x6f\xf3\xf6\xdc\x01\x1d\x0c\x10\xd8\x0e\xf5\x08\xf0\x15\xff\x9f\x94\x48\xca\x9b\xc8\x9b\x17\x68\x38\x0e\x7c\x0c\x38\xd8\x9c\x7f\xc1\x54\x9f\xb8\xb7\x0b\xd8\xb6\xf3\x82\x32\xf6\x12\x24\x69\x85\x65\x21\xbc\x88\x48\x5c\x0e\xf6\x22\x48\xdc\x97\x93\x28\xe8\x1a\xfd\x1a\xf4\x95\xa8\x32\xf7\x95\x22\x75\x98\x4d\x3f\x09\xb3\x52\x7f\x64\x25\xdb\x75\x4e\x29\x16\x8b\xc5\xde\x78\xca\x57\xa7\x5c\xb1\x58\x6c\x3b\xbf\xc5\x72\x71\x59\x2c\x16\x2b\xe3\x4d\xa3\x3d\xb0\x0b\xea\x8b\x51\x6d\xde\x18\x4d\xa8\xd4\x2a\xc9\xa4\x6a\xfb\xd5\xb0\x54\x5a\xd5\x0b\xc2\x6a\x5c\x6a\x51\xf3\x9a\xbc\x9a\xb5\xc4\xe5\x7c\x94\xa1\x69\x51\xb4\x3b\x94\xfb\xa5\xd6\xa8\x5a\x9b\xc2\x9e\xa6\x2f\xba\x85\xc1\xac\x4a\xd3\x32\x9e\x9c\xb5\xea\xa9\xd9\xae\x32\x41\xe3\x09\x5b\x55\x9b\x4c\x7d\x0e\x33\xf5\x34\xd3\x4e\xb6\xb0\x2a\xbb\xed\x55\x96\xdd\x58\x1b\x27\xe9\x32\x56\xac\xee\xcd\xd6\xb6\xdc\x28\x48\xcd\xb2\x8c\xd4\xca\x26\x3f\xb3\x48\x59\xe5\xd6\x49\xbc\x5b\xcc\x2e\x53\x83\xa5\xd4\x54\x75\xbd\xdd\x55\x89\x81\xd5\x67\x77\xc4\xbc\x01\x53\x18\x4c\x19\x79\xa4\x49\xd3\xfc\x7e\xbe\xa0\x20\x36\x58\xf7\x99\x5c\xee\x80\x4d\xe6\x83\xce\x98\x1b\xa0\x1e\xb9\xce\x6c\xfb\x7a\x91\x6b\xf7\x4b\x68\x56\x56\xa8\xa2\xd2\xb6\xb6\x7d\xae\x98\xa5\xd6\x07\x71\x32\x56\x6a\x8b\xe2\x14\x76\x7b\xb3\x41\x7d\x4d\x17\x8d\xde\x50\xd8\x56\x99\xf6\x8e\x1d\x57\x7b\xe5\x2e\x37\x69\xb6\x0f\x87\x12\x59\x6b\xb5\xd3\x55\xb9\x38\x91\x6b\xe5\xe2\x0c\xef\xad\xd6\x39\xae\xb2\xcf\x15\xe9\x45\xc1\x2a\x6f\x9a\xe4\xb4\x0c\xa7\x13\x6d\xb5\x87\xeb\x58\x8a\xea\xc9\x68\x3b\x29\xf1\x43\x7d\x41\x15\x37\xcd\x7c\xbf\xb6\x69\x59\x10\x63\xa0\x31\x4f\xa1\xf5\x72\x3a\x20\x0a\x18\x2d\x66\xd9\x39\xde\x5b\x50\x28\x35\x61\x52\x18\x6b\xcf\x7b\x36\x25\x9a\x34\x36\xb1\x52\x75\x62\xbd\xee\x77\xb3\x2b\x6c\xde\x98\x96\xf1\x39\x9a\xcb\x13\x95\x18\x8f\x38\x81\x42\x9b\x29\x45\x15\x4c\x34\x23\x09\xac\x5d\xd2\x07\x86\x88\x69\x31\x45\xe9\xf7\x3b\x19\xc5\x48\xae\x98\xb9\xa8\x8e\x27\x99\x74\x7e\x4a\x9b\x9d\x7d\x81\x9c\x0e\x88\x43\xba\x5b\x9b\x62\x64\x2f\x99\x63\x62\x59\x65\x9f\xa1\xcd\x79\x2c\x99\x1d\xd4\xad\x64\x76\xd0\xe5\xd5\xc5\x92\x28\xf0\x1a\x97\xb3\xaa\x4c\xaf\xaa\x5b\x18\x4c\x96\xf8\xc6\x28\xc6\x8a\xe9\x5e\xa5\xb8\x57\xf2\x31\x76\x30\xcf\xd7\x7a\x5c\xd2\x58\x74\xc4\x0d\x51\x5c\x24\x4b\xed\x2c\xc7\x1e\x04\x19\x5f\x8a\x6d\x55\x9e\xcc\xc5\x83\x9e\xaa\x12\xc3\x6d\x39\x65\x2c\x87\xda\x6c\x34\x9e\x65\x0b\x90\x22\x65\x33\x67\xe4\x0c\x6b\xc5\x12\x23\x2e\x9f\xcc\x72\xcc\x5a\x67\xd3\x48\xe0\x17\x3a\xd7\x59\x96\x05\xbd\x9f\xa6\x9b\x4c\xba\x4c\x64\x0e\x32\xd1\x35\xb7\x35\x44\xcd\x53\x6a\x0e\xe2\xfa\xac\xcc\x2d\x66\x78\x01\xca\x13\xd5\x4a\x2f\x21\xe2\xd1\xb6\x3a\xdb\xe6\xf2\xc6\xd6\xec\xd4\x48\x53\x29\x61\x87\x95\x31\xcc\x4f\xad\x25\xc9\x6c\x76\x69\x6e\xd8\xcc\x56\xaa\xb1\x81\x90\xc6\x99\xed\x5a\xc9\xf6\xe7\x3a\x3d\xe9\x49\x07\x76\x96\xea\xf1\xcb\x4d\x67\x85\x71\xb4\xdc\x1a\x53\xc6\x82\x26\x7a\x87\x0a\x65\xd1\x75\x7e\xbb\x37\x2b\xa4\xb1\xcc\xa5\x6b\x68\x96\x35\xb7\xf8\x16\xa9\x8a\x56\x53\xd0\xbc\xd8\x3f\xe8\xb9\xe9\x7c\x3c\x48\xe2\xb4\x21\xe2\x8b\x4c\x92\x48\xe3\x85\xd9\xb4\x3e\x5c\xa4\x62\xb3\xc2\x32\x56\xd7\xb3\x9b\xc6\x58\xa2\x85\xb4\xd1\xe1\x89\x9d\x38\xe8\xa0\x42\x8c\x20\x87\x46\x69\x55\x3a\x8c\x37\xa5\xca\x58\x9f\x0d\x35\x66\x48\xb5\x17\x93\x54\x8e\x31\x73\x10\xae\xba\x29\x66\x4a\xa5\x62\xe6\x60\x26\x9b\x84\x96\xea\xc8\x9b\xde\x10\xc7\x72\xdd\x7e\x7b\x3d\xda\xf6\x16\x72\x8a\x4e\xb6\xea\x45\xa6\x3b\x49\xc6\xb4\xf1\x76\x2e\xcc\x44\x66\xa1\x14\x7a\x58\xae\x90\x2d\x34\xeb\x38\xaa\xd6\xc6\x99\xd6\x6e\x32\xa6\x54\xad\x20\x72\x73\x5c\xcd\xb2\x0d\x56\xcb\xc4\x30\x46\x69\x77\x68\x0b\x9b\x4c\xf2\x56\xbf\x22\xa4\x51\x5e\x88\x55\x1a\xb9\xb5\x2a\x35\xba\x86\xa4\x24\x63\xbb\x8d\xd5\x9b\xcc\xc4\xde\xa4\xba\xec\x57\xaa\xbb\x24\x5d\x99\x52\x52\x5a\xef\x51\x92\x46\x2c\x08\x52\xa0\x31\x83\xd0\x92\x54\x69\x55\x67\xf2\x95\x9e\xbc\x4a\xb1\xa8\x51\x95\xf3\x56\xa5\x4b\xe4\x07\x8b\x91\xdc\x1f\xb3\x5d\x7e\x5d\x5f\xd4\x86\x5c\xa9\x6c\xc1\xac\x48\x74\xc4\xdd\x16\x65\x6a\xf5\x9e\xc1\x30\x26\xa1\x1d\x46\xd9\x98\xa9\xa5\xf8\xb2\xbc\xa6\x4a\xf5\x03\x9e\x8d\xb1\x6d\x51\x5e\x49\x14\x67\xf6\xd7\x6d\x25\xd7\x36\xd8\x36\x36\x16\xe7\xb1\x69\x6e\x3e\xc8\x37\x27\xa8\x5e\xdf\x16\x99\x18\x2f\x48\x3d\x66\x48\xd1\x29\x4c\x5b\x33\x85\xad\xb9\x43\x3d\x32\x17\x5b\xcb\xeb\x12\x49\x14\x96\xab\xca\xfc\xd0\xb0\x16\xf4\xb4\x96\x2d\xc9\xcb\x79\xa3\xd4\x3f\x60\xd9\xa5\x94\x5d\x1f\xe6\xc9\xdc\xba\xc9\x08\x44\xb9\x5c\xd0\xb5\xe6\x78\x30\xa7\x0b\xb1\x7e\xbb\x7f\x98\xd3\x4a\xbd\xcc\xa8\x1a\x5c\x72\x23\x29\xb5\xeb\x69\x93\xc6\xa0\x2a\x16\x8c\x6a\x6e\x5f\x9e\x0c\x47\xe9\xa6\xb1\xa9\x58\x0b\xb4\x5f\x60\xf3\x3d\x4b\x14\xe5\x36\x57\xe9\x4c\xc5\x03\x37\x84\xf4\x1e\x17\xd2\xfc\x5a\x16\x62\x2d\xa9\x8a\x04\x36\x6f\x4d\xf8\xd6\xac\xac\x8b\x1a\x59\x1a\x17\xbb\x55\x0e\x2b\x26\xa5\xb1\x44\xf2\x93\x75\x7b\xc1\x71\x7a\x5d\xe7\x08\x25\x43\xd7\xf6\xa5\x59\xd6\x68\xcd\xc5\x18\xd5\xdc\xe6\x4a\x8a\x25\x96\x96\x46\x4d\x4a\xd3\xb8\xce\xc7\x6a\x3b\x06\xcf\x97\x99\xc2\x92\xde\x24\x63\xd3\x6a\x29\x3f\x28\x37\x90\xc9\xb5\x62\xfb\x3e\x3d\xce\xb4\xa7\xf9\x42\xb1\x94\x11\x2a\xb3\xdd\x62\x22\x34\x69\x7e\x6f\x54\x89\x91\x38\xa2\x1a\x8c\xca\x51\xb1\xf6\xbc\x98\x9a\xc3\x24\xcb\xf7\x86\xb5\x81\xb0\xea\x8e\xb5\xae\x36\xcb\xc4\xd8\xfe\xba\xb9\x5f\x9a\xf8\x94\x5c\x34\xe1\xa0\xc1\x0d\xa5\x19\x23\xb5\xfa\x23\xe2\x50\xec\x65\x37\xac\x5e\xdb\x54\xa4\xa1\xd2\xc4\x3a\x3d\x4a\xe4\x92\x55\x38\x11\xcc\xcc\xb2\x54\x58\x15\x7b\x56\xe9\x50\x6f\xd7\xbb\xbb\x6d\x45\xe5\x8b\x62\x75\x90\x1b\xe2\x75\x61\xb5\x63\x27\x65\x59\x2d\x6d\x46\xfd\x06\xdf\x69\x75\xc4\x76\xaf\xd3\xab\x0b\x9d\xc3\xaa\x8a\x5a\xdd\x94\x5e\xc4\xd2\x83\xc6\x7a\x87\x57\x73\xcc\x1e\x6b\x2e\x72\x10\x9a\xdd\x15\x5d\xa9\x57\x46\xbc\xd4\xe5\x29\xae\x82\x4c\x2d\xcd\xe4\xf1\x3a\x55\x1c\xe9\xcb\x4c\xa6\x8b\x57\x73\x9c\x3e\xd1\xb6\x74\x91\xe8\x97\x93\x63\x9e\xab\xb5\x84\x52\x65\xb9\xc2\x46\xc6\x6a\x3f\xdc\x0b\x4b\xac\x9a\xe6\xb9\x7a\x1e\x61\x63\xdc\x60\x7a\x8a\x5e\x2a\xce\xca\x48\xa0\x51\xce\x20\x87\x25\xc9\xe2\x7a\x87\x81\x31\xec\xae\x7b\x23\xb5\x1e\x5b\xf1\x3b\x54\x68\x4d\x77\x1d\x02\x27\x30\x0e\x8f\x71\x0d\x36\x5d\x31\xaa\x3c\xc5\x40\x73\x71\xc8\x4f\x7b\x9d\x4d\x72\xc7\x4a\x99\x4c\xa5\x51\x57\x73\xb1\x9e\xb9\x3d\x34\x52\x95\x43\x7a\xa3\xe7\x99\xc2\xac\x4e\x15\x49\xa5\xb0\x67\x62\xed\x62\xde\x6a\xc5\x0a\x0b\x8d\xa1\x52\x19\x83\x91\x39\x2c\xb7\xe5\xea\x6c\xa7\x37\x62\x0b\x03\x69\x9d\x2a\xb7\x94\x75\x61\xd1\xe9\x2a\xbb\x0c\x85\x96\xed\x0c\x23\x17\x4a\x32\x27\xcd\x58\xbc\x80\xad\x1b\x95\x89\x98\xdc\x4e\x26\x8b\xf4\x72\x25\xc2\xcc\x40\x2e\xeb\x6b\x3c\x3d\x8c\x75\x3b\x92\x31\x8f\xb5\x0e\xad\x82\xc0\xb6\x54\xce\xe0\xe4\x51\x29\x2d\xef\x46\x49\x01\x65\x5a\x74\x32\x17\xa3\xf1\x18\xb5\xc6\x95\x56\x29\xb6\x1b\x25\x19\x29\xc6\x6f\x46\x86\x58\x63\xe7\x0a\xd1\x9e\x61\xa9\xe1\x36\x39\x8b\xd5\x54\xac\x47\x0f\x28\x3d\x45\x52\x6a\x3b\xa5\x6e\x49\xbe\x5b\xa4\x73\x22\x29\xcd\x71\xa5\x24\x89\x50\x99\x4a\xc3\x6c\x95\xda\x35\xa7\x69\x6a\x38\x33\x5b\x7d\x52\x28\xa4\xaa\x24\xc9\xf4\xca\xcd\x7d\x49\x68\x31\x3c\x86\x8d\x6b\x58\xa5\x47\x75\x2d\x73\x2e\x1d\x1a\xe5\xcc\x40\x2a\x4f\x79\x79\xb1\xee\xf7\xc9\x71\x4d\xdf\xd1\x99\x8a\x98\x5a\x6e\x52\x24\xcb\x52\x35\x03\xcf\xe0\xa5\x01\xb3\xec\x17\xac\x2c\x3b\x2f\xb3\xcc\x7a\x3f\x98\x6c\x9b\x96\xd4\x4d\x32\xa9\x58\xbe\xda\x5b\x36\x47\x53\x3c\xa5\xe0\xb1\xdd\xa6\x41\x56\x1a\x04\x53\xe9\x36\x95\xcd\xc0\x94\xe5\xe2\x8a\x9b\x34\x8b\x9b\x42\x55\x99\x68\x1b\xaa\x51\xad\x51\xf4\x68\xbf\xaa\xcf\x2b\xf3\xe1\x70\xd5\x9a\x1a\x68\x58\xcd\x19\x25\x81\xdd\xf7\x75\x66\xb3\x90\x33\x6b\x2a\xb3\x4a\xd1\xc3\x42\xa7\xd3\x5b\x54\xf3\x75\x72\x6c\x1d\x78\xbc\xa3\x89\x85\xed\xf8\x20\x19\x52\x7a\x53\x5c\x14\x76\xdc\x5a\xdb\x8f\xe7\xc3\x41\xbe\x33\xee\x65\xfb\x24\xd5\xcd\xa8\xe5\x94\x5a\x2d\x5b\x69\xbc\x8e\x11\xdd\xa2\xbe\x2c\x8f\x61\x69\x3e\x84\x35\xc5\xea\x95\x52\x5d\xc5\x2c\x0d\xb7\xdd\x66\xa6\xbb\xaa\x4f\xb6\xa3\x6d\x3d\x66\xc9\xe3\x99\x56\x1f\x90\xfb\x39\xbb\x67\x1b\xa3\x5d\x32\x35\xcc\x15\x5a\xec\x41\xe7\x88\x6d\x7f\x55\xd0\xaa\xc6\x40\x51\xeb\x15\x6b\xd9\x11\x8d\x32\x44\xea\x7e\x2d\xf5\x1b\xc5\x58\x79\x9c\x83\x25\x6a\x5a\x37\x0d\x8c\x4c\xe7\x9a\x4b\x7a\xb2\x4b\xb7\xc5\x02\x9d\x5f\x97\x04\x2a\x9d\xe3\xda\xaa\x61\x94\xc7\x02\x35\x9a\x25\xf1\x49\xb2\x47\x2e\x76\x49\x6b\xbd\xed\x64\xcb\xf9\x45\x89\x53\x7b\xe4\xe4\x80\xef\x7b\xe3\x39\x59\xa1\xcc\x75\x7b\xb0\xad\xa5\x4a\xcb\x7a\xc3\x1a\x2c\xd6\x7a\x29\x37\x1d\x8f\x09\x8d\x5a\xb7\xb1\x34\xde\x37\xac\x18\x33\x31\xd6\x22\x29\x17\x56\x83\x3c\xea\x15\xd8\x41\xb5\xb0\x39\x88\x53\x31\xc7\x2c\xd9\x9d\x65\x66\x58\x6d\x78\x40\xf3\xbd\x5a\xd3\xdb\x66\xc6\x84\xfd\x75\xab\x54\x1a\xd7\x52\xd5\x6c\x76\x5a\x18\x8c\xab\x82\x50\x60\xa5\x7c\x2a\x03\xcb\x45\x6e\x3e\x4b\x76\xcb\xa5\xd1\x41\x61\x38\x1d\xef\x88\x99\x79\xdd\x6a\xd7\xab\x58\x6f\xc8\x25\x8d\xc3\x3c\x37\x2e\xc9\xbd\x03\x3b\x23\x8b\x02\xcb\x48\xe9\x16\x97\xb7\xfa\x6b\xad\xa5\x0b\x3b\x4c\xe3\xe8\x2e\xd2\x3a\x68\xde\xe8\x49\x25\xa4\xd1\x42\x7e\xbc\xa8\xd0\xcd\xc2\x40\x9e\x8f\x11\x6c\x64\x50\x4a\x2e\x0d\xca\xdd\xa1\xc0\xf7\xfa\xe3\xc2\x6c\x5b\x9d\x8b\x2b\x95\x25\x09\x6d\xca\x91\xbd\x5e\x5b\xe9\x25\x63\x43\x16\x47\x73\x68\xb0\x26\x1a\x64\xb5\x2c\xec\x25\xd9\x18\x31\x32\xf9\xd8\x0c\x6b\x88\xab\x7c\xbf\xd8\xc9\xb5\x59\xbd\x9a\x2b\x31\xa9\xfa\xa8\x35\x51\xd1\x8a\x4a\xeb\x2d\xad\x44\x6d\x7a\xf5\xc2\xa1\x58\x6a\x0e\x32\xc9\x72\xbb\x9c\xdf\x25\x7b\x19\x22\x56\xab\xb3\x4c\xd3\x9c\x9b\x13\x36\xcf\x12\xe2\xc6\xda\x2c\x27\xd5\x55\x26\xb6\xc8\x4a\x83\xce\x61\x55\xc7\xf2\x8b\x18\x87\x31\xed\xc5\x7c\x4f\xed\x07\x50\x15\x56\x0a\xb6\xcf\xd3\x58\x41\x68\x08\x22\x5f\xc5\x15\xb3\xd5\x37\x95\xe2\x48\x3c\x98\xbd\x6a\x61\xd7\x29\xcd\x97\x06\xec\xd4\x4b\x4d\xb3\x9f\x1c\xaf\xe8\xf5\x62\x91\x54\x77\x4b\xb3\x74\xb0\x08\x91\x37\x24\x76\x51\x17\x97\x4a\x15\xcf\x14\xca\x2b\x7d\xa7\x18\x05\x11\x6f\xec\xf5\x7a\x3d\x3f\x99\xb7\xb3\x42\x5f\x22\x67\x52\x66\x8c\x6d\xf2\x69\x01\xb1\xd9\xbe\x60\x28\x8b\x7c\xa6\x9e\xd2\x46\x25\x05\x5b\x6e\xca\xf5\x2a\x1a\xa4\x3b\x6d\x69\xbf\x1e\x72\x3a\xc1\xe7\x68\x1c\x1b\x42\x03\xaf\x1f\xf6\xb4\x51\xad\x55\x0e\x68\xd0\xeb\xa6\x7b\x8b\x41\x6f\xc2\xa4\xab\x85\x06\x86\xa7\xc8\x96\x3c\x88\xf1\x59\x65\x2b\x2f\x51\x6b\x60\xc6\x14\x7a\xdb\xc7\x17\x1a\x9e\xad\x31\x55\x21\x97\x6f\x0f\x9a\x44\xb9\x54\x9c\xd7\xa7\xb5\x1d\x96\xd6\xac\x4d\xb3\x95\xdf\xf6\xea\x07\x5a\x48\x43\xa2\x4e\xf0\xd3\xe1\xa4\x25\x0f\xb6\xd3\x4c\x8f\x2b\xe2\x26\x63\xc4\x06\xd5\x98\x98\xa3\xc9\x0e\x65\x15\x29\x2e\x33\x22\xd5\x19\x5b\x2c\x8f\x3b\x0c\x5b\xd5\xd3\x1d\xab\x88\xb6\x13\x2a\xa3\x5b\x3c\x2c\xc6\x4a\xe9\x12\xa5\x6e\xb3\xca\xac\xda\x89\x1d\x30\x55\xcf\x16\xcb\x8a\x84\xca\x0b\x4e\xde\xaf\xe0\x61\xbd\xee\x70\x0b\x75\xdc\x28\x12\x70\xd4\x8b\xb5\xea\x49\x6e\x80\x55\xe1\xbc\x6a\xf5\x46\x99\x74\x75\x55\x5a\xaf\x6b\xa8\x44\xb0\x85\x19\xb1\x2f\xeb\x45\x6a\x33\x9d\xea\xbc\x1c\xab\xcb\x49\xae\xb7\x27\xe1\x7e\x16\xab\x9b\x49\xb6\x38\x5c\x16\xd7\x5c\x83\xd2\xa7\xa9\x31\x8f\x0f\xed\x6d\x41\x71\x3c\x9d\xf5\x47\xed\x4c\x79\xd9\x6c\xbe\x06\x5d\x2a\xa4\x88\x5e\x23\x25\x63\x0f\xba\x10\x14\x41\xd9\xd9\xc0\x44\xfc\x5d\x97\xef\xb1\xb4\xdd\x43\xc1\xbc\x10\xcf\x69\x18\x2e\x8e\xbc\x05\xf6\x4a\x2f\x98\xbb\x2b\x74\x37\x8b\x6e\x2e\x98\xbb\xd1\x39\x26\x05\x29\x0c\x4c\xac\xb7\x06\xd4\xf6\xce\x96\xc9\xfd\x1a\x27\xec\x04\xa7\x84\x2e\x0a\x92\x93\x03\xb4\xbe\x99\x02\xb4\xcd\x0b\xd8\x22\x56\xc8\x66\x2a\x87\x7e\x52\x9b\xe4\x48\xaa\x9d\xc6\x5b\x63\x34\x6c\x16\xb7\x33\x6e\x34\x3b\xa8\xd4\x41\xc9\xe8\xd2\xa2\xad\xa6\x97\xec\xc8\x6c\xc4\xf2\x24\x85\x26\x55\x7c\x20\x64\xd7\xc2\x41\x71\xe1\xde\x4a\x03\x7a\xc1\x5c\x9c\xdf\x6e\xa2\xcf\xc8\x6b\x3d\x41\x8b\x8a\xc1\xb0\x22\xa9\xb9\xdb\x3e\x72\x4d\xee\x30\x51\xa0\x74\x4c\x55\x54\x15\x6a\x89\xb5\x8e\xe1\x09\xdc\xce\x6c\x32\x24\xc6\x2f\xbc\x4f\xd7\xb4\x9f\x82\x93\x64\x59\x6d\x6c\x99\x71\x6b\x98\xe5\x5b\x68\x9f\x69\xcf\x54\x1e\x0d\xf8\xc3\x7c\x5d\x98\xf7\x71\x5a\x6c\x4c\xba\x75\x92\x68\x55\x56\x96\x26\x0f\xb7\x69\xbd\x96\xcf\x32\xcd\x46\xaf\x72\x48\xce\xf1\x9f\xa4\xeb\x07\xb2\xd0\xd6\xe1\x24\xb4\xdb\x44\xb5\xd6\x63\x69\xc6\xed\x99\xa4\x4a\xa8\x8b\x12\xae\x8d\x04\x6a\x35\x2d\x2e\x95\x66\x73\x9f\xed\x6b\xc3\xec\x4c\x5b\x37\xab\x64\x8d\xc5\xe4\x56\xfd\xd0\xdc\xd5\x2a\x3a\x9b\xde\x25\x77\xcd\x6e\xac\x94\xcc\xad\x47\xdd\x9f\x9f\xac\xcb\x04\x34\x27\x8d\x49\xa7\x15\x0d\xfe\x0b\x4f\x14\x12\x78\xa0\x20\x7e\x9f\x9a\x4c\x65\x7e\xd0\x0a\xe3\x34\xc9\x6d\xc7\xc4\xbc\x6d\x0e\x34\xbe\xd6\x6e\x91\x9c\xba\xdc\x37\xfa\x25\x9d\x25\xb0\xca\xce\xa8\xb4\xfb\xa3\xfd\xb6\x6c\xa6\xf4\x25\xd4\x0a\x34\x56\xdd\x31\xfc\xa0\xdf\xc9\x97\xeb\xfc\x0f\x50\xf3\x8f\x78\x1c\x54\xa0\x09\x45\x45\x95\xa0\x8c\x80\xe9\xfa\x4e\x80\xc2\x82\x99\xe1\xb9\x4c\x78\x28\xaa\xac\xed\xdb\x75\x03\x7a\x40\x54\x38\x4e\x90\xb9\x1f\x62\x86\x69\xc0\x7f\xa5\x12\xd9\x04\x9e\xf4\x72\xf0\x0c\x78\x87\x01\x05\xa3\x20\x1e\x28\x8c\xd7\xf2\x10\x4f\xd7\x3b\x0d\x98\x99\x54\xfb\xda\x44\x68\x10\x43\x64\x65\x2a\x8b\xd4\xca\x2a\x2c\x30\x2e\x47\x6f\xd7\x79\x7c\x9e\xea\xd2\xd5\xee\x2e\x53\x6e\xf7\xf5\xc3\x8e\xa1\xf2\x6b\xee\x83\x0c\x00\xf1\xf8\xdb\x4f\x53\x71\x7f\x2a\xf3\x28\x46\x76\x44\x63\x3a\x93\xe5\xcc\x78\x30\xa8\x63\x3d\x0a\xae\xca\x8d\xec\x64\xde\x34\xc9\x45\x53\xc2\xb8\x0a\x65\xa0\x91\x89\xaa\xb0\x2a\x1e\x76\xbb\x39\xb9\xea\xc5\xea\xd8\xaa\x59\x65\x9a\x18\x1b\xdb\xff\x75\x53\x39\x72\x7c\x6d\x7f\xe9\x8c\xc6\x5d\xff\xdd\xbf\x88\x44\x32\x91\x3d\x72\xc4\x2b\xbd\xc3\x94\xc9\xa8\x54\x35\x7b\xcb\x11\x2b\x5b\x6b\xc6\xda\x63\xfc\x74\x56\x15\xe6\xc3\xbe\x48\x25\x99\x41\x6f\x2f\xc4\xca\x49\xac\x6f\xac\xfa\xcb\x43\x67\x60\x16\x06\xb9\x6e\x0a\xad\x52\xeb\x6d\x1b\xf6\x17\xb1\x8d\x3a\x26\xfe\xc6\xe9\xbd\x4f\xd2\xfd\xb9\x86\xbd\x71\xdd\x5c\x16\x29\x65\x8a\xe9\x6c\x3f\xcd\xd4\x4d\x7c\x9b\x2f\x67\xf2\x92\xd6\x6b\xe9\x05\xc2\x28\x29\x7b\x19\x9b\x0d\x33\xe3\x7c\xac\x5d\xc2\x16\x5b\x49\x50\xe8\x6a\xa5\xb8\xe1\x18\xb2\x5c\xef\x77\x27\x7f\x87\x12\x7a\x3f\x0b\xf6\x36\x3d\x0a\xb9\x69\xd7\x16\x73\x64\xac\xa9\xd6\x22\x67\xd5\x57\x8d\x54\x93\x38\xe0\xdd\xc5\x36\xbf\xa1\x93\xa3\x2d\xdb\x95\xf7\xb5\xd2\x92\x46\xa5\x52\x17\xc3\xeb\x19\xad\xb0\x52\x3b\xf5\x1c\xd4\x61\x96\x9d\x30\x46\xfa\xa3\xf4\x04\x08\x0a\xe4\xc4\xee\xe2\x08\x4a\xaa\x48\x22\x78\x8a\xed\x94\xbd\x9c\xa9\x89\x5f\xf3\xf6\xe5\x32\xc2\xe2\xc6\x22\x8f\x11\x8f\x38\x2d\x1a\xba\x2d\xf9\xc7\xfc\x51\x5d\x14\x18\x18\x01\xcf\x36\xd4\xa8\x5f\xfa\x67\x14\xc4\x80\xc0\x78\x61\x22\x27\x34\x69\x92\xe2\x65\xb8\xe7\x45\x39\x06\xb9\xae\x64\x70\x9d\xbb\xe0\x45\x01\x3c\x9f\x85\x01\xa3\xbf\x5c\x0c\x67\xc6\x59\x45\x7b\x8d\x3c\xd8\x58\xd7\x35\xc5\x50\xed\x6c\x78\x06\xee\x1e\x81\x20\x03\xbb\x50\x6f\xca\x4e\xb9\x1e\xf1\x80\x39\xe8\xc7\x91\xf2\x1a\x71\x1a\x46\xc0\xb3\x87\xcf\x37\x10\x25\x69\x3b\x9d\x20\xfa\xec\xc2\x00\xaf\xaf\xaf\x20\x09\xbe\x47\xde\x82\x2e\x7d\x00\x5e\x30\x45\x0c\xfc\x0a\xc6\xfc\x4e\x24\xc9\x47\x97\xfb\xbd\x66\x4e\x80\xe7\x87\x68\x78\x1f\xd9\xf3\xa8\xd2\x29\xd3\xd6\x1b\xc6\x2e\xf0\x01\x3b\x50\x6d\x04\x28\x41\x66\x9e\xed\x12\xb7\xfe\x58\xb4\x81\x5e\x4c\x2d\x61\x18\x02\x63\x33\xe2\x08\xef\x4a\xc4\xe9\x6a\xfc\xe4\x6a\x5a\x66\x04\x3c\xbb\x6e\xfa\x2b\x53\x7a\x25\xec\xe8\xcc\xd9\x6b\xc4\xe9\x19\xa2\x2f\x18\xae\xbd\x9d\x01\xea\x45\x0a\xdd\x6c\x59\x2f\x32\x79\x16\xc8\xbd\x0a\x4f\xd7\xe2\x8a\x2c\xee\x23\x6f\x03\x0d\x9a\x82\x62\xe8\x97\x3d\xc2\xa1\xb7\xdb\x64\xdb\x69\x99\x9f\x23\xdb\xe9\xf9\x23\x64\x1f\x33\x40\x7f\x92\xec\x1e\xdc\xa1\x77\x48\x0e\xc7\x1a\x79\x0d\x60\x17\x01\xaf\x1f\xd3\x54\x03\x57\x53\x31\x21\x2d\x15\x7a\x80\x18\x70\x94\xc4\xab\x6a\xcc\xae\xf0\x12\xf8\xdc\x04\x14\xa4\x19\x32\xed\x0c\xf2\xec\x1c\xfc\xf0\xe5\x5a\x13\x03\xbc\xfd\xf5\x1b\xf0\x4b\x9d\xa4\x8a\x0b\x12\x2f\x35\xe5\x95\x0c\x6e\xfb\xf1\x51\xe4\x67\x5b\x51\x43\x3b\x6d\xe5\x35\x62\x27\x45\x8f\x8f\x2d\xcf\xea\x0d\xfb\xf4\x8f\x7c\xbb\x81\xa4\x98\xf6\xd1\x1b\x3b\x7d\x66\xa5\x28\xd2\x5c\x40\x7c\xd9\xc9\x01\x09\x6a\x55\x41\xe2\x80\x19\x17\x58\x8f\x28\x9e\xd4\x83\xc0\x9e\x9d\x85\xce\xa9\x41\xbc\x21\x51\x32\x29\x88\x03\x12\xf1\xe0\x7f\xff\x6f\x97\xdc\x13\x11\x76\x71\xe4\x8c\x87\x36\xe8\x10\xa5\x11\xf0\xec\xec\x4c\x8f\x0c\x74\xd1\xa5\x45\x81\xde\xbc\x46\x14\x15\xca\xe3\xf3\x0c\x97\x08\xc0\x2e\x90\x85\xa2\x0e\x3f\x15\x5b\x83\xf6\xcf\xaa\x5e\x2a\x76\xed\xd8\x9a\x9a\x6c\xe0\xaa\x5d\x52\xc7\x4b\xdd\x59\x75\x21\xa4\x63\xd3\xf4\x60\x5a\x27\x0c\x6a\xdf\xdb\xb4\x06\xdd\x03\x2a\x0b\x6a\x9b\x21\x20\x91\xe9\x4d\x67\x33\x61\x25\x6d\x89\xfc\xa2\xbd\xb5\xfb\x94\x17\xa5\xe6\x7c\x61\xc3\xc9\x55\x8b\xc5\x62\x7f\x57\xac\xcf\xda\x56\x9a\x2a\x16\x8b\x35\x2a\x29\x56\x87\xb3\x51\x5a\xee\x13\xcb\xc9\x8c\xa5\x46\xfc\xb8\x91\xa7\xab\xa6\x55\x6a\x4e\x2a\x65\xab\x46\x32\x4d\x83\x9e\xf3\x82\x28\xb7\x14\x69\x9f\x43\xf2\x76\xb2\x4a\x6f\x97\xb5\x8e\x55\x65\xab\x2a\x35\xec\xf5\xcb\x03\x62\x61\x9a\x87\x2a\x77\xb0\xe6\xb5\x92\x5c\xce\x64\x65\x94\xcf\xe8\x63\x42\x3d\xe8\x3a\xbb\x9e\x0f\x33\x07\xae\x5a\xfc\xb9\x3f\x95\xb4\x49\x88\x74\x56\x32\x72\x9b\x16\x3b\xcf\xe5\xd9\x41\x16\x4b\x4d\x98\x2c\x86\x9b\xec\x42\xc8\x68\xd2\x74\xd0\xcb\x60\xf9\x0c\x9a\xf7\x4c\x6a\x26\x1b\x99\x21\xc9\x1a\x75\x8d\xd8\x09\x87\x61\x81\x49\x1a\x75\x1e\x87\xe9\xc1\xb2\x50\x30\xb7\x42\x5d\xcc\x6c\x58\x2a\xdf\x85\x1b\x8a\xec\x6f\xcb\xf2\x34\xc5\x54\x78\x65\x2b\x6c\xf2\x93\x7e\xa1\xb9\xc0\xd9\x0d\x9a\xcc\x62\xe6\x21\x16\x2b\x77\x8c\x05\x2a\xa4\x19\x79\x20\x31\x9d\x64\x36\x3b\x5d\x93\x94\x3c\x27\x5a\x8b\x96\x46\x75\x89\x9a\xd8\x4f\x4e\xc8\x85\xaa\xb1\xd4\x5a\x5b\x20\x6c\xb9\x16\x89\x49\x3a\x9b\xda\xa5\xd8\xb9\x84\xd8\x2e\xd9\x5f\x89\x04\x2e\xe5\x93\x38\x3b\x4a\xe9\xa9\xfc\x6a\x89\x36\x31\x6d\xcb\x6e\xb2\x75\x62\x7b\x58\x97\x92\xf2\x94\xe0\xb9\xf4\x60\x9a\x4e\xcf\x58\x79\xb6\x48\xaf\xe6\xfa\x6a\xbb\x6b\x25\xb1\x18\x53\xed\x77\x32\x83\x4c\xa1\x52\x30\xcd\xac\xc5\xca\x5b\xb2\x94\xb4\x32\x8b\xcd\x7a\x30\x66\xb7\x58\x2e\xc5\x1b\x29\x7d\xae\x35\x88\x5d\x6e\x50\x86\x07\x4d\xeb\x76\x59\x5c\x1d\x14\x19\x7a\x56\x29\x54\xb1\x32\xdf\xc3\xbb\x83\xc3\x10\xc6\x18\x82\x3f\x2c\x92\xca\x30\x23\xc5\xcc\xca\x36\x5b\xcf\xf1\x5b\x33\x37\x5e\x34\x50\xa5\x48\x2e\x19\x35\xdd\x9b\xc9\x24\x36\x1d\x72\xc9\x16\x3b\x88\xe5\x96\x23\x3e\x9d\xc6\x6b\x52\x03\xa5\xf5\x0e\x56\xd7\x06\x93\xdc\x5a\xc5\x62\xed\x42\x72\x4b\x66\x1a\x6b\x8d\x15\xea\xf3\x14\x9a\x2c\x65\xba\xbe\xc7\xa6\xd9\x61\x63\x24\xe4\xcc\x6e\x31\x99\x6f\xf7\x89\xb2\xc4\x4c\x44\x6d\x99\x9c\x19\xc4\xe4\x60\xb5\x1b\xfd\xb6\x4c\xb5\xf9\xe1\x3c\xa5\x8e\xa7\x93\x8a\x38\xd8\x53\xd9\xe4\x70\xde\x2d\xe4\x07\x24\x96\x32\xbb\xe5\x1d\x46\x96\x9a\x95\xf4\x8e\x26\xa4\x2a\x19\xeb\x96\x64\x71\xb8\x13\x48\x5e\x32\xc4\x2d\x96\x1c\x0c\xf3\x74\x76\xbb\xab\x64\x17\xf8\x88\x63\x52\xbd\x71\xbe\x30\xcc\x96\xd3\x7a\x96\xaa\x1c\x4c\xbd\xbc\xc3\x56\x49\x51\x5e\xcc\x97\x25\x2d\x67\xcd\xe7\xa9\xc5\x22\xa9\x68\x56\x7a\x89\xf8\xc3\xce\xda\x0e\x7a\x32\x6c\xd4\x3a\x29\x61\x29\x55\x63\xb9\x4c\x6e\x4a\x66\xab\xfd\x41\xbf\xdb\xda\xd2\xfc\x5a\x2a\x0d\x31\x23\x1d\xdb\x9a\xc5\xf9\x92\x69\x2d\x7b\x22\x3f\xcf\x1b\x32\x0e\x2d\x51\x6a\x11\x6a\xa7\x51\xd6\x75\x2b\x63\xd6\x78\x7e\x59\xca\x2c\x5b\xb1\xa4\xbe\xed\x18\xab\x19\x86\x25\x93\x5b\xda\xa0\x65\xaa\x9b\xe1\xa6\xbd\x1c\x73\x30\xbb\xc5\x14\xcd\xb4\x94\xc6\x5a\xce\xe3\x7d\x0d\xe5\xb1\x32\x9d\xda\x5b\x9d\x46\x3f\x87\x5a\x8d\xb2\x75\xa0\x25\xb4\xad\x52\xf9\x76\x5f\x93\x31\x6d\x32\xd5\x17\x94\x36\xdc\xed\xb6\x75\x3d\x1f\xa3\x24\x7d\x55\x52\x06\x0b\x02\x6b\xa7\x64\x53\x12\xcd\x54\xa5\x5e\x6d\xac\xb7\x05\x86\x90\xaa\xe3\x79\x3f\x33\xc0\xb6\x07\x6d\xcc\x4e\x17\xf9\xcd\x22\xbd\x29\xce\xfb\x0c\x45\xac\xf7\xec\x94\xed\x70\x1b\x5a\xc5\x2a\x43\xab\x9e\x99\x1e\x38\x99\xce\x1a\xc6\x82\x65\xf6\x6a\x77\x9e\x25\xca\x3b\x11\x6d\x95\x7c\x26\xbf\xad\x9b\xb9\x7c\x6c\x5c\x30\x9b\x8d\x3e\x6b\x4e\xf8\xe1\x20\x57\xb0\x26\x73\xb2\xd7\xb5\x50\x2d\x5f\x97\x74\xbd\xad\xeb\xe5\xdd\x64\xbd\xa5\xb3\x95\xde\xa0\x36\xe1\xfb\x69\xba\x5e\xca\x50\x26\x46\x49\xa5\xd5\x48\xc9\xc7\xca\xd8\x7e\x20\x61\x03\x6e\x4a\x2d\x16\xc2\x0c\x33\x5b\x53\x33\x3b\x4e\x57\x65\x9d\x9d\x73\x7a\xa3\xa7\x09\x05\x86\x90\x6d\xbc\xd8\xad\x49\x53\x52\x5a\xdb\xcf\x73\x7b\x69\x52\xa6\xd9\xd9\x9c\x9b\xe1\xa6\x54\xc6\x54\x69\xa5\xb3\xa9\x0e\x24\x8c\xc5\x78\x62\xd5\xa4\xc6\x78\x5e\x61\x1a\xfc\xa4\x8f\x89\xc5\x1e\xcc\x8d\x96\x75\x65\xd5\x19\x0c\x75\x3a\x9b\xdd\x55\xea\xf3\xd2\x8e\x63\x52\xad\x82\xcc\x0a\x28\xd6\x25\xf4\xce\x80\xca\x56\x45\xb2\xc7\xaf\xfb\x95\xd8\x81\x92\x32\xdd\x0d\xdd\x5b\xf1\x0d\x4a\x40\x62\xac\xb4\xcc\x16\x0c\x99\x42\x32\xb9\x66\xc7\x82\xd8\x65\xad\x4e\xa3\x34\xcb\xe4\xf2\xa3\xde\x6e\xb9\x82\xf5\xd9\xa0\xb5\xb6\xda\xe9\xec\x6e\xc6\xa7\xc6\x5b\x5a\x96\xe7\x2b\x66\xd1\x16\x0e\xc6\xbe\x20\xad\x86\x78\xb3\x7e\xa8\x18\x66\x71\xbb\xc3\xc4\xf2\x7a\xb7\xcc\x63\x49\xb3\x46\xa9\x5a\x6d\x9b\xcb\xda\x70\x70\xab\x70\x98\xcf\x2b\x5c\x41\x59\xc6\xda\xac\x9c\x5b\x98\xdc\x68\x99\x53\x77\xea\x1e\x9b\xd0\x87\x29\xa1\x77\xa6\x84\xbe\x16\x34\x9b\x26\x06\x96\x4b\x2b\xe9\xb0\xea\x6b\x85\x1d\x95\xec\x2e\x33\x79\x73\x62\xd5\x16\x4c\xcf\x5a\xeb\xab\x75\x87\xdf\x74\xc6\xed\x6c\x65\x62\x91\xea\xca\x2c\x28\x8b\x22\x8e\xb2\x1b\x8e\xea\xf6\xb3\xf9\x4a\x2c\xd6\xb5\x16\x04\x33\x6c\xa1\xc6\x2e\xbf\x4a\x57\x56\x3d\x5c\x1e\x53\x66\xb9\x40\x54\xb0\x3c\x01\xb7\xa9\x81\x30\x1a\x94\xb6\x78\x83\x5c\x6d\xf4\xfc\x40\x2a\x21\x8a\x58\x8d\x57\xab\x24\x2e\x55\x99\x58\x27\xd9\x59\xd0\x12\x9b\x21\x16\x78\xaa\x30\xc1\x16\x55\xab\x32\x23\x16\x73\x85\xb5\x32\x35\x5e\x4a\xc7\x60\xa3\x49\xe9\x5a\x1f\xcb\x2a\x33\x7e\x98\xd9\xd7\x65\xaa\xde\x55\x65\x1c\xeb\x56\x48\x93\x6f\x8c\xf1\x49\x7e\x90\xb4\xb2\x9a\xd5\xaf\x4b\x46\x7d\xd2\x18\x88\xa2\xc9\xe5\x5b\x29\x86\x1a\x14\x99\x15\xce\x4c\x60\xb7\x86\xc9\xfc\x30\xa6\xe6\xa9\x03\x4d\x94\x31\xf6\x50\xaa\xc4\xb2\xa9\x45\xde\x20\xc8\x6d\x03\x33\x67\xe5\xb4\x88\x99\xad\x43\x7e\x70\x58\x8c\xab\x8d\x98\xb9\x8d\x49\xb9\x11\x1b\x13\x87\x92\x59\xe8\xe2\x74\x4f\xe5\x6b\x13\xbe\x8b\x13\x69\xa6\x47\x51\xa9\xac\x20\x2b\x85\x6c\xba\x8e\xb8\x7a\x6c\x1c\x53\x37\x6a\x99\x5d\xe7\x0f\xbc\x30\x9f\x62\x3c\x69\xb5\x07\xad\x4e\x29\x97\x32\xe4\xb4\x9a\xec\xcb\x93\x64\x8a\x59\xaf\x33\x8a\x51\xcb\x67\x65\x3a\xc7\xe6\xe9\xdc\x88\xa1\x53\xfd\x8d\x8c\xe4\xc3\x21\xbd\xc9\xcd\xcc\xc2\x44\x82\xb9\x49\xb1\x2f\x37\x66\x64\xc9\xb2\x58\x0c\xdb\xe1\xb2\x4a\x65\xfa\xd8\xa8\xb6\x32\x47\xda\x32\x66\x24\x25\x66\xd2\x19\xab\x93\x43\x85\xe7\xeb\x8d\xc2\x68\x1c\x5b\x48\x06\x31\xa9\xa4\x17\x0c\xc1\xc2\x5c\x6c\x61\xb0\xa3\x64\xf9\x27\xd7\xa4\x7c\x0f\x4b\xd7\x08\x22\x2f\x1c\x98\xfa\x6e\x3e\xcf\x5f\xfa\xb8\xdf\xb3\x30\x80\x77\xb2\xe1\xcc\xe8\xc0\xde\xde\xb3\xc8\x1c\x70\x76\xd6\x6b\xd0\x36\xe2\x33\x67\xd5\x8e\xf1\x17\x09\x5a\x4b\xf6\x3f\x13\xa7\xf4\xcd\xb7\xff\x8e\x45\xe0\xfb\x0b\xc6\x67\x3e\x00\xcd\x36\x67\xde\x5e\xa0\xf4\xd6\x53\x80\x53\xf8\x82\x41\xe9\x2d\xd4\x59\x3d\xef\x0b\x77\x28\x6c\x9b\x06\xd0\x3a\x1e\x2a\x01\xff\xfc\x27\x38\x2f\x49\x88\x50\xe6\x10\x1f\x32\x65\x59\x41\x26\xc5\xe9\x99\x3d\x0b\xc0\x8b\x2e\x91\xa2\x78\x2d\xe9\xeb\x9f\x1a\xa9\x69\x5f\x8f\x26\xaf\xdf\xdb\xa6\xd8\xe9\x13\x34\xf2\xd5\xbb\x44\x84\x06\xb4\xb7\x12\xfe\x3e\x35\xea\x9e\xb0\x72\xfe\x8d\xab\x82\x28\xba\x04\x3b\xa7\x0c\xdc\xaf\x96\x46\xaa\xc0\xde\x04\x39\x6d\xca\x76\xb7\x9a\xa2\x8d\x11\x89\x0c\xfd\xe1\xf1\x34\x25\xba\x53\x02\xbe\x7b\x1b\x92\x17\xd2\xdf\xd0\x22\x92\xf3\xf7\xb3\x09\x44\x72\xfa\x71\x93\x85\x48\x2e\xe1\x66\xee\x85\x32\xbc\x7c\x02\xee\xe0\x16\x09\x51\x10\xb7\x31\xb4\x01\xda\x1b\x17\x07\x29\xe7\x87\x3d\x83\xdf\x43\x1b\x22\xf5\x63\x62\x7a\x96\x96\xe7\xed\x1d\x8f\xd9\xb8\x3e\x82\x48\x06\x14\x92\xed\x63\x96\xce\x29\x56\x55\x13\x24\x52\xdb\x3b\x65\xba\x04\x1c\x38\x2e\x85\x61\x03\xbc\x02\x11\x29\x88\xba\x6b\x7d\xbf\xcd\x04\x68\x01\xaf\xc8\xc6\x36\xb0\x4f\x0d\x0f\xa1\x43\x5a\x91\x99\x6b\x83\x00\x56\x54\x48\xe4\x66\xd3\x1f\x79\x7c\xda\x02\x84\xb3\xe8\x66\x82\x2e\x20\x27\x3f\x35\xc0\x9f\x00\x4b\x3e\xbd\x3f\xb4\x87\x6c\xb8\x27\xb3\x26\xf6\x01\xaa\xf0\x3e\xd1\x3d\x55\xe5\x0b\xbc\xf3\xc3\xf9\x37\xae\x23\x4d\x50\x21\xe3\xfd\xe2\xed\x9d\x99\x5f\x23\x81\xcb\x03\x5f\xa7\x6d\x25\xb2\xcb\x8f\x10\xed\x1f\x71\xd1\xe1\x42\x60\xf2\x90\x76\xf6\x10\x20\x1e\xe8\xb4\xa2\xba\xc9\x91\x91\x37\x17\xdf\x17\x0c\xf1\xf7\x5a\xcd\xec\xf3\x5f\xe7\x8d\x5e\xb0\x13\x60\xbb\xc6\xbb\x3d\xc2\xed\xed\xe7\xf7\x1f\x51\xf0\x1f\x09\x97\x0e\x20\xc8\xc0\xa3\xe8\x24\xce\xb4\xf7\x80\xb9\x18\x3d\xb8\xf5\x8f\xe7\x4f\x30\x3a\x12\xeb\x56\xc7\xed\xeb\x16\x1c\xa1\x77\x7f\x27\xec\xdf\xb6\xdc\x23\xe6\x7e\x3f\xe7\x40\x5b\xb0\xa3\x53\x10\xee\x19\xa2\xf1\x44\xd5\x0b\xe6\x4c\xc4\x67\x85\x64\xe4\xab\xcb\xab\x62\x12\xde\xc8\x87\xce\xf0\x9d\x66\x9f\x27\x3c\xad\x7c\xa6\x90\x2f\x74\xf1\xdb\x71\xb8\xe7\x17\x8c\x27\x4e\xb3\xf4\x19\x71\x8c\x7c\x6c\xc8\xc0\xdc\xbf\x2f\xa2\x17\x42\x7a\x29\x80\xd3\x51\x27\x2c\xa3\x97\x8d\x5c\xd5\xfc\x7e\xbb\x8e\x42\x3b\x47\x2b\x2e\xa4\x1e\x0b\xa2\x11\x12\xea\x4b\xb1\x3e\x13\x6c\x9f\x05\x40\x90\x4f\xec\x08\xfb\xc6\xee\x88\xa1\xdf\xc7\x75\xf0\x84\x05\xd8\xe9\x7b\xd6\xec\xb4\xe8\x20\xe6\x13\xa3\x88\x1e\x0b\xae\x3c\x2b\x61\x26\x04\x89\x0e\xc8\xfd\x4f\xea\xc9\xf2\xe9\xb4\xe9\x87\x1e\x82\x8b\xd3\xa9\x57\x1e\x03\x24\xea\x91\xb7\x49\x67\x0c\x02\xc0\xff\x42\x91\x77\xc0\xdf\x97\x86\xb7\x5b\x1a\x6a\xa0\x29\x48\xa1\x15\xd1\xe1\xf6\xbd\x19\x42\xa2\x9e\xf0\x03\x7c\xdf\xc1\x83\x57\x42\x0b\x2a\x0f\xb5\xb1\x21\x20\x08\xbe\x3f\xba\x40\xb0\xb0\x62\xf7\x38\x78\xc2\xd6\x3e\xf4\x2a\xc8\xf6\x03\x7a\xfc\x71\xf9\x80\xbe\x87\xf9\xd8\xa0\xd6\x90\x46\x1f\x42\xdc\x19\xe2\xf7\xe4\x1f\x09\xdd\xed\x04\xbe\x5f\x45\xf5\xfe\x80\x4d\x5d\x37\xa0\xf6\x63\xe3\x09\x4e\x9f\x4f\x0d\x37\x2e\xf6\xf4\x77\x07\x7b\x38\xa7\x8e\x94\x75\xdb\x43\xf9\xfb\x1f\x8f\x89\xb5\x22\xc8\x0f\xd1\x27\x10\x7d\xfc\xd4\xe8\x33\x52\x14\x98\x1f\xa3\x55\x56\x50\x09\xb2\x8a\x06\xc1\x77\xf0\x4f\x99\x21\x75\xfe\x2b\xb8\xd2\xa6\xc8\xa2\x4f\x72\xa4\x0d\xf7\x3f\x86\xd1\x06\xee\x27\x7b\xd5\xc6\xe7\x4a\x8d\x7d\x02\x18\x7c\x07\x94\x80\xf4\xa7\x8b\x7a\x5d\xe0\x64\x12\x19\x1a\x2c\x8a\x9c\xa2\x09\x88\x97\x3e\x37\x87\x8d\x62\x3c\x95\xc9\xfe\x18\xd6\xac\x20\x73\x50\x53\x35\x41\x46\x63\x9e\x4c\x65\xb2\xf7\x86\x3e\x86\xc2\x6c\x3d\x14\x8c\x82\x9d\x9e\x2c\x5d\x14\x68\xf8\x80\x3f\x46\x6e\xa2\x59\xb6\xdb\x81\x5f\x7e\xfd\xe6\xf6\x07\x31\x80\xfb\x63\xde\x41\xda\x1e\xf1\x9d\x07\xea\x05\xf3\x1f\xfe\xff\x80\xe6\xee\x29\x08\xea\x1d\x41\x47\xef\xa9\xec\xd3\xf1\xff\xf3\x38\x88\xc7\x4a\xbb\x1a\x08\x32\x70\x9a\x1d\x37\x16\xce\x3d\x01\x81\x3d\x8e\xf3\xdb\xd9\xe3\xd8\xed\x4e\x9b\x1c\xf7\x97\xb7\xcb\xf9\x79\xd3\xdd\x3d\xbc\x66\xef\x09\xee\x50\xa5\x29\x16\xb8\x7a\xdc\x3c\x72\x23\xe8\xaa\x88\xf1\xf4\xb9\x9d\x13\x0c\x7a\x86\x43\x9b\xd7\x63\x98\xe1\x38\x56\x08\x7e\xfe\x0a\xfc\x13\xe3\xfd\x51\x9c\x12\x6f\x4f\xe4\xf2\xdb\x1f\xec\xd4\xf6\x12\xce\xd9\xa6\xc3\x07\xe5\x15\x7a\xc0\xbc\x5f\x47\x70\x67\x5d\x2e\x21\x86\x4c\x59\x1f\xe6\xb1\x38\xec\x60\x38\xc2\x0d\x75\xbc\xc2\xd3\xb0\x7d\xe0\xc3\x46\xa2\x0f\x15\x89\x27\x78\x17\xcd\xaf\x32\xfc\xa7\x9e\x13\xbd\xb4\x3f\x1d\xcf\xbc\x21\x59\x27\x1b\x26\x75\x3c\x63\xe9\xde\x0f\x15\x4f\xbb\x7b\x7e\xf7\x58\xfa\xf9\x3d\x06\x40\xa5\xe2\x44\xe4\xcd\x86\xa9\x03\xea\xfc\x14\x28\x9f\x0a\x3d\x6b\xb6\x2d\xe0\x65\x4a\x34\x1d\xad\x13\x07\x38\x78\x71\x76\x95\xa7\x7e\x65\xb7\xc1\xc9\x7d\xe3\x3d\xa3\x67\x1d\x05\x19\x78\xbf\xf5\x89\x32\xe6\xbd\x3b\xec\x42\x82\xed\x66\x62\x78\xbc\xf7\x59\x71\x39\xd0\xef\x61\x94\xfe\x70\xe3\xf8\xc1\xc7\x42\xff\x81\xce\x4e\xfb\x60\x82\x6a\x38\x4d\xe0\xe3\x28\x9c\x79\x4c\x82\x54\x5d\xf7\x9e\x78\x27\xca\xff\xe5\xb9\x38\xce\x39\x04\x62\xaf\x00\xcf\xd8\x09\x1e\x82\x6e\x4b\x19\x73\xd1\xe0\xed\xf5\xbd\xa9\x08\xb9\x43\x82\x9e\x16\x91\x73\x3e\x9c\x2b\xc4\x40\xf8\x36\x80\xc8\x9b\x33\x40\x57\xd1\xe0\xe9\x30\xf8\x5f\x21\xd5\xce\x29\xe1\xbf\x55\xa0\xbd\x73\xc8\x3f\x22\xcb\x3e\x5e\x7f\x93\x04\xfb\xe0\xaf\x08\xcd\x75\xa9\xbd\xd3\xe1\x5d\x59\xbd\x3f\xd8\xff\x11\xf9\xbc\x60\xef\x7f\x8f\x54\x9e\x96\xee\xbf\x4f\x28\x6f\xc8\xa2\xcd\x99\x0b\x41\x0c\x4b\xe0\xa9\x91\x9f\x34\x75\x29\x7b\x01\xab\xe2\x42\xf2\x7e\x3f\x1b\xe5\x8a\x9e\xbc\xde\xee\x32\x53\xea\x3a\x24\x3b\xeb\xe6\x34\xfa\x87\x64\x28\x40\xc4\x15\x01\x0a\xd6\xbe\xbd\x86\x78\xf2\xdf\x23\x36\xde\x71\xfd\x77\x4c\xbe\x8b\x9b\xa4\x22\x3f\x25\x4c\xc7\xab\x01\x02\xe2\x74\x7e\xee\xdd\xeb\x1c\x08\x87\x78\xb2\xc6\x08\x2c\x1b\x79\x2b\x2b\x92\x4a\x6a\x90\x01\x48\xb1\x37\x53\x76\x61\x82\xf6\xca\x26\x0a\xf8\x0e\x58\x4d\x91\x8e\x35\x8a\xc8\x8c\x11\xa9\x21\xc8\x14\x1d\x3b\x59\xfd\x91\x41\x9d\xb8\x51\x4f\x01\xaa\x97\x62\x06\x74\xa8\x3b\x6e\x09\x8b\xd4\x01\x27\x98\x50\x06\xac\xa2\x01\x77\x74\x41\x57\xe4\x44\x70\x80\x90\x37\xc2\xc5\x3e\x10\xac\x22\xde\x7a\xd0\x02\xd3\x51\x47\x3f\x4f\xf1\x0a\x46\x3e\x74\x83\xa6\xa1\xae\xbb\x3b\x70\x87\x20\x19\x5a\x53\x4d\x3c\x6e\xbe\x5d\xa9\x3a\x45\x5e\x02\xae\x9e\xb3\x5b\x4b\x6c\xf3\x36\x6e\xc8\xce\xf1\x14\xe6\x22\x95\xd3\x7b\x4e\x0d\x4d\x04\x82\x0c\x82\xe3\x44\xec\x60\x8e\x17\x4f\xb8\x1a\x4a\xf8\xf5\x1b\xf0\xfc\x75\xe4\x45\x2a\xa6\x21\x9e\xd3\x3b\x72\x12\xa6\x98\x77\x68\x66\x6c\x01\xd1\x02\x24\x6b\x6e\xb7\xff\x08\xd9\x81\xb1\xfe\x52\xd2\x5d\xb1\x67\xdc\x3b\x41\x6e\xd3\x6e\x91\x9a\x2c\xc8\x5c\x80\x78\xef\xd6\x36\xb7\xdf\x87\xa8\x0f\x6c\x30\xfd\x7c\xd2\x0b\x48\x91\xf3\xa7\xdb\xbd\x14\xee\x9c\x3f\x7c\x26\x40\xff\xed\x50\xd2\x59\x56\x9e\xcb\x89\x60\x58\xf5\xd3\x1e\xc7\x0b\xff\xf5\x07\x7c\xe8\x57\xfd\xe8\xd7\xdc\xdf\xee\x6c\x5c\xba\xc9\xaf\xb5\xed\x8b\xcc\xc7\x1a\xf6\xa0\x75\xad\xe1\x15\x67\x4a\xd8\xad\x7e\xdd\x99\x1a\xf2\xbb\xb8\x33\x75\x8c\xa6\x1e\xef\x5a\xb9\x82\xd7\xcd\x10\x91\xdb\x29\xc1\x0a\x50\x64\xae\x39\xce\xdf\x73\x9e\x7b\xfd\x95\x9f\xea\x2d\x43\xeb\x7a\xef\xab\x9c\xba\x70\x31\x63\xb7\x37\xab\x97\x6e\xa0\xcf\x9b\x54\xde\xed\x31\xef\x2c\x8e\x97\x37\x0e\xfe\xdc\xea\x78\xba\xb4\xe6\xc7\x97\x47\x1f\x17\x5f\x3d\x38\x49\xe7\x11\x7b\xfd\x92\x15\x39\x6e\x41\x0a\xf8\x2d\x80\x05\x35\x08\x58\xfb\x5a\xb8\xf3\x15\xeb\xb3\xb1\x01\x67\xa1\xfc\xab\x03\x5e\xb6\xb5\xfd\x7e\x24\x6b\xa0\x68\x1f\x68\xe5\xf1\xf5\xfd\x86\x25\xe7\x92\xc8\xbf\x32\x2c\xe6\x31\x1d\x08\x32\x38\x5d\x4e\x74\x2d\xb0\xe5\xd5\x26\xec\xcb\xa8\xee\xc4\xbf\xfc\x66\xf6\xd9\xd1\x0f\x34\x73\xc3\xc1\xb7\x57\x1c\x41\x66\x95\x90\x04\x25\xfc\x28\xd2\x71\x7d\xb9\x13\x60\x3b\xbf\x5d\x33\x12\x1c\xda\x2d\xfa\x3f\x12\x5f\x73\xee\xc1\x7a\xe7\xd9\x0d\xdd\x61\x79\x35\x53\xdd\x69\x13\x00\x19\x79\x3b\xa2\x74\x1d\x5c\xe8\x46\xc4\x40\xd7\x8e\x5b\xd3\xf7\x2a\xce\xcd\x03\xaf\x12\x38\x2d\x13\x89\xc4\x95\x25\xdd\x1b\xc6\xbf\x61\xf1\xe6\x01\x16\xbf\x41\x9c\x22\x35\xfb\xb6\x40\x77\x82\x4f\x4c\xf1\xfb\x7b\x87\x1a\xfc\xe6\x14\xa9\x79\x27\x12\x1c\x85\x2d\x2b\xd6\x6b\x24\x19\x2c\x91\x04\x39\x5c\x42\xee\x5e\x23\xa9\x4c\x32\x19\xe2\xca\x85\x4e\xfe\x71\x6f\xe2\x9a\x34\x49\xb7\xd4\xa3\x93\x35\x64\xda\x89\xd6\xaa\xa4\xa6\xc3\xb1\x6b\x87\x3f\x78\xf6\xf8\xe3\xf1\x52\x46\x11\x22\xe7\x78\x06\x78\x3d\x16\x01\xff\xb4\xe0\xb3\x6f\xbe\xfb\xd1\xc5\xa7\x63\x0b\x3b\x94\xac\x9f\xea\x9d\x9f\xa7\x5a\xdb\x7a\x3a\x55\xda\xbf\x02\x3d\xbd\x47\xfa\x19\xfc\xfe\xc7\xa9\xd4\xd9\xf1\x5d\x16\x5d\xba\xb8\xec\x36\x5e\x93\xef\xc7\x7b\x69\x35\xf0\x60\xd3\x61\xf7\x98\xba\xa6\xa9\x3f\xb6\x03\xf7\x31\x40\x9a\x4d\xab\x5b\x9a\x50\x0d\x9d\x7f\x38\x6b\xf8\xbb\x07\xe1\x8f\xc7\xaf\xb7\xc6\xb0\xf7\xbf\xe1\x01\x2e\xb1\x0c\x8e\x68\xf7\xf2\x8f\x9d\x05\x99\x0c\x1c\x58\xcf\xce\xbf\x4f\x81\xd2\x23\x2b\x8e\x65\xdf\x8f\xdf\x2e\x48\x55\xd8\x77\x30\xf9\xdd\x06\xff\xc7\xe3\xd9\xb8\x1e\x36\x1f\x60\xc3\x15\x14\x8e\x0c\xbc\x1c\xcb\x05\xe5\x41\xbf\x60\xe1\xbd\x8e\xba\xa2\xa1\x87\x07\xf2\x09\x50\x8f\xe0\xf5\x2d\x80\xac\x06\x91\xa1\xc9\xc0\x9f\x32\x6f\xa1\x8e\x03\xea\xac\xe0\x38\xd4\xe3\xd7\xf0\x7c\x91\x0c\xa3\x05\xe7\xcb\x17\xbf\x0b\x99\xf0\x2b\xce\xf9\xe1\x97\xfe\x6e\xc3\xf9\xe3\x3a\x4d\xc7\x9e\xef\x53\x61\xaf\x54\x4e\x1e\x85\x08\xbd\x5d\xf9\x03\xe5\x14\x3e\xda\x3b\x15\xd2\x5d\xa2\x1c\xea\x14\x0d\x5d\x52\xe5\xc1\xb1\x47\x3d\xbb\x83\x75\x66\x38\x57\x02\xa8\x8a\x0c\x65\xf4\x10\x1d\x5c\x8b\x24\x44\x9f\x8e\x08\xf9\x9a\xff\x19\x44\x7f\xb9\x1b\x75\x88\xfa\x72\x69\x1f\x24\x95\x04\xef\xf9\x8b\xfe\xfa\xcd\x0e\x5e\x7f\x8f\x1e\x1f\x56\x1b\xa1\x87\xc7\x4b\x82\xaf\x08\x9d\xe7\xe5\x79\x06\x78\xe6\x42\xb8\xbe\xfb\xf0\x54\x4d\x51\xf5\xe7\x40\xf7\x5b\xba\xa0\xa8\x69\xe4\xfe\x6c\x4e\x6c\x66\xdd\xe1\xc9\xd1\x0f\x7d\x9f\x1d\x17\xee\xea\xff\x2a\x4e\x84\x09\xf7\x1b\xdb\xe4\xda\xf6\xed\x45\x7b\x8f\xa0\x87\x73\x35\xa0\x41\xdd\x10\x91\xad\x93\xbe\x07\x4a\xcf\x54\x0c\x50\x58\x80\x78\x41\xbf\xd4\xa3\xf6\x1f\x81\x05\x0f\x6e\x44\x4f\xd1\x91\x63\x36\x09\xb2\x07\x35\xdc\xd4\x1f\xed\xf7\xb3\xf6\x7f\x04\x55\x90\xfd\xf5\x28\xe9\x1e\x65\xc0\xb6\x93\x3f\x06\x0a\xbc\x5e\xb4\x03\xc0\xd6\xaf\x7f\x26\x0c\x59\xd8\x1a\xb0\xc9\x3c\x44\xed\xd6\xfe\x19\xe0\x3f\xa3\x8f\x4f\x17\x1d\x7c\xe5\x6b\x7f\xfe\x11\xaa\xfd\xfe\xe5\xd6\xaf\xef\x67\x5c\x75\x26\xfc\x4f\x37\x3b\x50\x7f\xf0\xf8\xf1\xf5\x72\x8e\xef\xca\xeb\xf8\xdc\x43\x7d\x43\x5c\x6f\xf8\xb1\xff\x4a\x69\x0d\xb8\x66\xff\x02\x51\xbd\x4b\x73\xdd\xb7\x41\x6f\x50\x7b\x61\xa3\x7e\x94\xce\xbb\xa8\x3d\xfd\x98\x96\xb9\xf7\xb0\x49\xe4\x06\x56\x48\x44\xea\xf0\xe2\x61\xb3\x9f\x28\x59\x61\xa0\xee\x3c\x6f\x5f\x43\x35\x90\xe1\x9c\x9a\xdf\xff\xf8\xfa\xe5\x73\xcf\xa2\x5d\xd8\x64\xc0\x2b\xf8\xb7\xfd\xed\xcf\x5f\xbf\x1d\xcf\x39\x7f\xff\xf7\xf9\x43\xe5\x60\xe1\xba\xf5\x99\x6b\x4f\x8d\xfd\xcc\xb8\xb5\xe1\xc7\xc3\xb9\x9a\xf8\xf9\xe8\xbd\x0a\x57\xdb\xd7\xa6\xab\xcf\x20\xaa\x3a\x33\x18\xaa\x74\x9e\x86\x67\x80\x9f\x3f\x43\x5f\xbf\x5c\x57\x28\x76\xe6\xfb\xa5\x0a\x39\xb2\x03\x91\x9c\xcd\x8d\x3b\x4d\x5d\xb6\x22\x92\x73\x79\x82\x48\xee\xcf\x5f\xbf\xd9\x49\xee\x3c\xa9\xf3\x61\x8e\xf8\x43\xff\xe3\xc1\xed\xe0\x24\x98\x30\x50\x7f\xbc\x06\xd7\x67\xa0\xd3\xf4\xba\xd6\xf1\xb9\xe8\x34\x79\xba\x5a\xed\xb1\xd2\x4f\xbb\xbf\xde\xc8\x67\x28\x22\xb9\xe8\xf5\x16\x3e\x57\xaf\xd5\x7e\xbf\x24\xf2\x86\x3e\x0d\x13\xe5\xaa\x2e\x3b\x4c\x43\x5c\x81\x71\x51\x02\x99\xa3\x0e\xbf\x06\xd9\x8e\x2e\x1c\x25\x0a\x20\xc5\xe3\xcb\x25\xe0\xc7\xaf\xef\x28\xdc\xeb\xb2\x62\x5b\x66\xf7\x84\xc5\xae\x3f\x4a\xcb\x8d\xc6\xae\xb8\xd8\x95\xae\xbc\xd8\xdf\xfe\xfc\xf5\x9b\xfd\x71\x5b\x58\xbc\xe6\x1f\x92\x16\xb7\xed\x7d\x71\x71\xdb\xdc\x95\x17\xbb\xc9\x7d\x59\xb1\x5b\xbc\x23\x2c\x7f\x91\xac\x78\x24\x05\x84\xe5\xef\x90\x15\x77\x94\x4f\x08\xcb\x0d\xc1\x39\x8a\x85\xbf\x25\x0b\x6a\xd5\xfb\x1b\x39\x7f\xe6\xcf\xb7\x4f\xde\x96\xe4\xe5\x15\xe0\x8f\x17\xdc\xb2\x7d\x25\x82\x6c\xc0\xaf\xf7\x24\xd9\x8f\xd8\x3b\x92\xe7\x1b\x27\xbf\x7e\xf3\x87\xb9\xad\xc3\x8f\x1d\x6f\xa9\xf1\x63\x83\x1b\x9a\x3c\xea\x11\x1c\xbd\xa5\xca\x4f\x37\xa7\xdc\x54\xe8\x20\x76\x83\x23\xff\x03\x88\xc7\xbb\xda\xde\x99\x0a\x7f\x65\x3b\x03\x71\xc9\xc8\xbb\x72\xe3\x4a\xcd\x95\x85\xcf\x15\xa1\x23\x17\xbe\xdc\x97\xa1\x90\xcc\x5c\xda\x74\xbf\xdb\x8e\x78\xfb\xaa\x1c\x7b\x8d\x1f\x43\xf4\x70\x34\xf2\x3c\x05\xf0\x04\xc2\x2d\x1c\xbc\x1f\xff\xb8\x6d\x35\x49\x8a\x21\x3b\x56\xc4\xd1\x5f\x73\x66\x38\x38\xa2\xf9\xab\x7d\x05\xc6\x44\xa0\x37\x0f\x0f\xa1\x8d\x25\x00\xbf\x3e\x44\x7f\x71\x0f\x5f\x45\x1f\x13\xbc\xc0\xc0\x87\xc7\xaf\xa1\xea\x2b\xce\xb4\xe8\xa3\xf3\x22\x90\xf3\xb6\xbe\x2b\xc8\xb6\x5e\xc0\xab\x3b\x74\xd0\xa2\xb9\xd6\xf6\x42\xf0\x1c\x4e\x3c\x1f\xe1\xfc\x9e\xfc\xe3\x5c\x70\x1c\x86\x04\xea\xf1\x3f\x6e\xd8\xd1\x8e\xd9\xe3\xb9\xda\xc0\xeb\x89\x10\xdf\x1d\x17\x7d\xfc\xfa\x25\xd4\xdc\xbb\xd9\x08\xbc\x1e\xa7\xa1\xe7\x96\x3c\x1c\x7b\x47\x1f\x6d\x8c\x9c\xe1\x9f\x42\x98\x8b\xe4\x5e\x31\xd0\xf3\xe5\x83\x24\xa9\x9a\x1d\xe1\xec\x78\xf5\xce\x25\x40\xe7\x44\x7d\x7f\xba\xc6\x83\x30\x20\x9d\x27\x55\xdb\x8e\x65\x14\x14\xbd\xdb\xdf\xe3\xd1\xa5\x32\x71\xde\x47\xf3\xcd\x7f\x7d\xa6\x6d\x19\x28\xd1\x70\x67\x00\x74\x49\x51\x10\xff\x11\x44\x55\x7e\xaf\x0b\xf4\x95\xa1\xa0\xec\x24\x66\x5c\x85\xe1\x3c\xb8\x34\x2c\x22\x91\xd4\x53\x25\x52\x3f\x37\x81\xfd\x3f\xba\x9d\x24\xcd\x75\x1c\x55\xf0\x0c\x52\x44\xf2\xe9\x46\x13\xfb\xcd\x50\x88\x94\xed\xf7\xf7\x24\xf0\x7c\xf8\x11\x0d\xf7\x92\xc8\xdd\x0c\x8a\x0a\x2d\xa0\xfd\x33\xc0\xd3\xd9\x0b\xda\x15\xd1\x84\xda\x33\x88\x86\x71\xbc\xd0\x5f\x48\x90\xa0\x8e\xa0\xfd\x1a\xa1\x04\x91\xb9\x80\x83\x48\x4a\x10\x85\x83\xf7\x16\xd2\x4b\xfa\x8e\x1c\x42\x9a\x01\x2f\x69\xb3\xf7\x22\x4e\x5f\xdd\x7e\x15\x50\xf2\x0a\xf5\x86\xca\x90\x08\x36\xbd\xbb\xa5\xec\x56\xf7\x69\x0f\xfd\x74\x34\xf4\x95\x99\x73\xad\xef\xcb\xf2\xa3\xf8\x44\x7f\x49\xe5\xc9\x5c\x3a\x13\x7d\x8f\xd5\x8e\xd9\x79\x17\x50\x32\x99\xa3\x58\xf6\x7d\x40\x8e\x4d\x72\x17\x12\x9e\x23\x53\x54\xfe\x7d\x48\x81\xf5\xe8\x2e\x3c\x96\xa5\xf1\x64\x2e\xfa\x71\x13\xe1\x5c\x99\x78\x8a\x24\xa1\xc8\x0f\xd1\x33\x49\x38\x2a\x9f\x27\x7b\xe5\xd2\x48\x49\xbf\x50\xc8\x9e\xe6\x82\x9a\x1d\x76\xb4\x17\xb7\x57\xbf\x69\xe2\x24\x14\x00\x03\x5e\x19\x52\x10\x29\x3e\x82\xff\xb1\x5f\x8b\x74\xbe\x1c\xf9\xca\x2f\x41\x22\xa4\x3d\x44\xcf\x22\x0d\xd1\x27\x70\x01\xf3\xd1\x7e\x87\xf1\x43\xd4\xb9\x30\x35\xfa\x04\xfe\xfd\xeb\xb7\x13\x12\xdf\x7f\xfb\xf7\xe3\xd7\x8f\xd0\x4b\xc3\x10\xc5\xcd\x23\xfc\x8a\x22\xdb\x1b\xf3\x87\x2b\x14\xbf\x83\xaa\xfd\x00\x84\xb0\x8b\xda\x6f\x81\x8a\x86\x16\xe0\xdb\x8b\xd5\xe5\xc2\x76\x83\x02\x1f\x77\xf8\xe0\x0c\xfa\xf5\x4b\xb0\x7d\x48\xaa\x18\xa8\x23\x4d\xd9\xff\x55\x8b\x6f\x78\x41\xfd\x1e\xf2\x16\xdf\xf2\x7a\x94\x4f\x49\x65\x37\xfc\x1e\x57\xd2\xce\x3e\xed\xf9\x70\x43\x33\x7d\xe7\x64\xc8\xc7\x1c\x51\x81\xb8\xfe\x0d\xfc\xae\x85\xfe\x3f\x8d\xe0\x29\x3e\xf4\x71\xc7\x51\x4f\x41\x35\x3b\x36\x7f\x13\xc7\xc8\x0b\x8f\xbf\xf5\x15\x45\xd5\x13\xa0\xa2\xc8\x51\x04\x36\xb2\x62\x01\x8b\x87\x1a\x04\x88\x27\x11\x10\x74\x3b\x84\x88\xbf\x45\xee\x0e\x74\x96\x3b\x7b\xc7\x85\x1c\xbe\x9b\xf0\xd3\xdc\xb0\xad\xf8\x31\xb2\xd7\xc9\xa7\xbb\xce\xab\xf7\x7d\xc0\xfe\xad\x7b\x17\x4e\x60\xcf\x5d\x49\xf3\x86\xbc\x79\x38\x39\x98\x9e\x00\xf1\xc3\x4e\xcb\xe3\x51\x94\x1b\xac\x09\x5f\x86\xf6\x53\xfe\xbb\xb0\x14\x1f\x2d\x6a\x88\x78\x85\x39\x6b\x7e\xf5\x32\x86\x0b\xf7\x9c\x44\x22\x9a\x07\xaf\x00\xfb\x7f\x1e\xfe\x17\x13\x7b\xfc\x5f\x3a\x96\x80\x3b\x48\x9f\x78\xe2\x1d\xa0\x3d\xd3\x43\x8e\x2b\xc0\xe9\x79\x0e\xf0\xc8\x59\xef\xc6\x85\xe3\x4d\x04\xd1\xaf\x77\x0c\x5e\x77\x80\xb2\xc2\x40\xf0\xea\x06\x6e\x9b\x32\x7a\x70\xc0\xff\x9e\xfc\xe3\x62\xe0\x40\xf3\x37\x90\x2e\x14\xee\xa3\xe0\xa6\x09\x9e\x8f\xef\x6e\xf2\x2f\x60\x11\xef\xc1\xf2\xd2\xee\x3e\x04\x2c\xf5\x1e\x30\x3b\xe0\xfe\x21\x48\xf8\x7b\x90\xbc\xec\xcf\x6b\xc0\x7e\x66\x72\x02\x86\xc8\xf9\xd5\x76\x0f\xd0\x84\x72\x28\xd8\xf1\xab\x5b\x98\x70\xf3\xfe\xdc\x75\xef\x1b\x88\x1e\xdf\x37\x1e\xb5\xf7\xd5\x76\x04\xf0\x21\xf5\x18\x3d\xdb\x84\x06\x86\x09\xdf\xa1\xf7\x73\x03\xe1\xb7\x07\xba\x72\x15\xdf\xb5\xb1\x1c\x8f\xc9\xf1\xe5\xa5\xaf\x97\x63\x8b\x8a\x0e\x75\xf4\x10\xbd\xfd\x26\xf8\x68\x68\x63\x7a\x1f\xf9\xb8\x7b\x4b\x6c\xf4\x19\x3c\x78\x2d\x6d\xc0\x0b\x10\x3f\xa1\x91\x50\x58\x56\x87\xe8\xc1\x4e\xef\x64\xd1\x23\xc0\x02\x55\x8e\x9d\xf1\xf0\xe8\x19\x56\x20\x06\xa2\xbf\x39\xd7\xb2\x04\x81\x2d\xaf\x03\x43\x8a\x7a\x0e\xcb\xbd\x9a\xfe\x1c\xd8\x4d\x7e\x5e\xb9\x2f\xf0\x1a\x3f\x3d\x2c\x34\xe7\xb3\x02\x59\xd2\x10\xd1\xe5\x6e\x5c\xb2\xbb\xfb\xca\xd2\xe1\x7a\x24\xfc\xba\xcd\xc8\x59\xa7\xb3\x0e\xf6\x69\x55\xe6\x21\x9a\x70\x0a\xdd\xbb\x7d\xa2\x8f\x8e\xbb\x39\xa0\xd2\x0c\x4d\x7c\x1f\x42\x60\x3a\xed\xbb\x53\xa2\x8f\x9e\xa1\x67\xa7\xba\x46\x9f\x4e\xfe\xb3\xd0\xd5\x8b\xef\x03\x0e\x09\xcb\x11\xb0\xae\xd1\xf7\xe0\x7a\xad\x48\x11\x9d\xb5\xba\x4f\x8b\xf3\xeb\x21\x6a\x9b\x69\xd1\xdb\x73\x17\xbc\x6a\xe6\xaf\x9d\x38\x26\x00\x39\x72\xd1\xc3\x39\x53\x39\x72\x82\x40\xfe\xa2\x2a\x88\xf0\x21\xfa\xce\xe9\xcc\x9b\x07\x33\xcf\x9f\x36\xdb\x1f\x32\x33\x60\xc8\x77\xe6\x5c\x55\x79\xb1\x8d\x72\x80\x3c\x07\xd8\xea\x14\xdc\xdb\x8b\x6a\x50\x76\xde\x34\xec\xd3\x90\x70\x0b\xce\x1b\xd9\x8a\x5c\xa0\x47\x4e\x4d\x4d\xd6\x03\xad\x43\x35\x67\x46\x7e\xe2\x57\xc7\x41\xf6\x10\x3d\x63\xdf\xc5\xbb\xc2\xa3\x17\xfc\xd4\x6e\xb1\xf2\xfe\x01\xd5\xfb\x67\x53\x3f\xcf\x54\x0f\x4e\x90\xad\x5e\xd1\x47\x18\xab\x7d\x94\xa7\xda\xcf\xb0\xf3\x8c\xd6\x6b\x1c\xf5\x8e\xd3\xde\x94\xd2\xf7\x4f\xea\xbe\x7f\x48\xf7\xf3\x2c\x3e\x42\x0a\x32\xf9\x58\xf8\x31\x36\x9f\x28\xfc\x28\xbf\x03\x3d\x3e\xcd\xf8\x10\x0f\x2e\x59\x1f\x38\x79\x7c\x93\xf9\xf7\x0f\x33\xdf\x3f\xc7\xfc\x79\xa6\x23\xf1\x8c\xdd\x48\xfc\x10\xa3\xcf\xe9\xf9\x18\xab\x43\x7d\x3e\xcd\xec\x0b\xea\xa3\xff\x89\x15\xd4\x14\x74\x01\xb9\x37\x00\xb8\x27\xd1\x6e\xaf\xa1\x1f\x84\x07\xad\xb8\x46\x5a\xc7\x87\xf6\x3d\xa8\x5e\xbb\x8f\x2d\xcb\x47\xe8\x1a\xd4\x55\x45\xd6\xdf\x47\xda\x4e\x48\x7e\x07\xf6\xad\xf5\xf7\xe3\x3b\xcb\x73\x05\x75\x7b\xf7\x7d\xed\x3e\xb5\x4f\x6f\x35\x8f\x9a\xfb\x6a\x16\xc8\x95\xcd\xe6\xf5\x3b\xc9\xc0\xb7\xd0\xde\xcd\x2d\x4f\x08\x32\xad\x41\x52\x87\xfa\x18\xd2\x86\xed\xd8\xbc\xb5\xc1\xf1\x4e\x0f\xdc\xde\xe0\x04\x80\x32\xf0\x87\x80\x5e\xdd\xcc\x5d\xfa\x08\xa2\xd1\x4f\xcd\x5a\x58\xbb\xdd\x9e\xb7\xeb\x57\x9c\x7d\x7a\xe6\x02\x0b\xc2\xc7\x5d\x49\x37\x74\xc4\x1d\x57\xcf\x8d\x4b\xa9\x3e\x8d\xb6\xa3\x52\x7f\xc0\x41\x17\x36\x85\x6e\xa3\x7a\x71\x0b\xcb\xa7\x71\xf4\x6c\xc4\x8f\xb3\x35\x70\x5c\xf8\xdd\x54\xb6\xbf\xc5\x3b\xe4\x61\xe7\x22\x67\xbf\xea\x06\x1d\xcf\xa6\xbe\x82\x6f\xdf\x12\xdf\xbf\x7f\x0d\x54\x79\xa1\xcd\x3f\x13\x70\x87\xa0\xcc\x3c\x5c\xcd\xa1\x7f\x02\xdf\x00\x6d\x68\x1a\x94\x91\xf3\x3e\x9d\x67\x60\x09\x32\xa3\x58\xc7\x8b\xd2\x9c\x64\xa3\xe3\x46\xd1\x85\xec\xbe\x3c\xc6\x0b\x51\xce\x0c\xe8\xf4\xd4\x8e\x6b\xad\x53\x6d\x93\x79\x24\xc6\x3e\x2d\x68\x87\xd0\xa2\x58\xf4\x09\x90\xa2\x40\xea\xf6\xf7\x2b\x2f\x77\x8f\x3e\x81\x23\xc3\x9f\x3f\x96\x12\xfc\xf8\x74\x64\xde\xcd\xe4\xb7\x3b\x69\xdb\xe0\x7b\x70\x81\x3f\x21\x7a\xfe\x96\xf8\x8f\xe0\x75\x4a\xcb\x0d\xa3\x14\xc4\xe0\x9d\x01\x5d\x09\xba\x3b\x5c\x38\xab\xf2\x27\x46\x73\xc3\xc9\xf7\x06\x3b\xa5\x33\xde\x1d\xe6\xe9\xaf\x67\xbd\x73\x1c\xe3\x3e\x23\xec\x16\x7f\x13\x6e\x4f\xfe\xe9\x10\xa7\x8d\xf3\xfd\x16\xba\x5e\x64\xe3\x2e\xaa\xc1\xf8\x48\x10\x59\x37\x92\xe1\x8c\x61\x7f\xbd\x35\x84\x1f\x4f\xb8\xcf\x8e\x60\x90\x23\x38\xc8\x29\x1a\x71\x96\xea\x7f\x63\xb0\xff\xb9\x3b\xc8\x59\x94\xe2\xf1\xb8\x84\xfe\x71\xa6\x9a\xec\x55\xfc\x48\xd3\x69\xd9\xb6\x23\x4d\x1e\xb3\xbc\x17\xfc\x47\x1f\xbd\xa3\xd7\x8e\x5f\xfb\x21\xca\xc4\x65\x3b\x40\xf7\x78\x76\x30\xe0\x08\x2d\x7c\xd4\xf1\x0d\x24\xcf\x81\xfb\x0d\x7e\x04\xba\x49\x6a\x80\x54\xd5\x93\x32\x3b\xaa\x31\x27\xb9\xe8\x17\x52\x55\xa3\xc1\x54\x63\x97\x89\x1f\xd4\xea\xae\xa2\x7c\xf6\x3e\xbf\x9c\x22\x6a\xe7\x27\xa1\x02\xe7\xb8\x1c\x03\x13\xb0\x24\x03\x23\xc0\x0e\x03\xda\x97\x56\xbc\x46\xe2\xb8\x7f\x70\x8b\x11\x48\x51\xe1\xae\xbd\x45\xc6\x3d\xc0\x1a\x72\xac\x5d\x9e\x7f\x73\xb7\x01\x2e\x18\xd7\xb8\x8d\xef\xc4\xab\xa7\xe0\xdc\x4a\xdb\x85\x08\x65\x74\xe3\xa6\x2f\xb7\x8d\x6b\xb1\x85\x0f\x92\x9f\xb7\x71\xaf\xe5\xbe\x3c\x27\xee\x1a\xe5\xde\x29\x30\xf7\xc7\xe9\x98\xba\xed\x90\xf5\xde\xbc\xc3\x08\xba\x24\x1c\xc1\x79\x0c\x70\x52\xc0\x5e\x23\x65\xa7\xdd\xb5\xf7\xe7\x5c\xb2\xe9\xed\x9f\x4e\xd2\xc4\xd7\x6b\x6f\xd1\x09\xde\xaf\x01\xee\x5f\x41\xe6\x12\x15\xba\xd8\x3c\x70\x63\xf4\xcd\x1b\xae\x43\x6e\xc8\xc8\x9b\xf3\xf2\x95\xeb\xef\xaf\x89\xb8\x6f\x63\x89\xb8\x6f\x1d\x8d\x00\xec\xed\xee\x9b\x7e\x2e\xd0\xbb\xb8\xd0\xfa\x1d\x7e\xfb\xb7\x93\x1c\x43\x09\xd7\x79\xff\xe6\xf0\xfb\x1d\x76\x5d\x3f\xff\xe7\x7c\xf9\x6b\x45\xfe\xcc\x25\xf9\xff\xcb\xfb\x7f\x58\xde\xef\xdd\x7d\x18\x02\xe7\xdf\x06\xe2\xee\xc6\x81\xb7\xc1\x7d\x3e\x3f\xf4\x1a\x3e\x8f\x7e\xed\x12\xf0\x8b\xcb\x00\x3e\x72\x77\xf4\x25\x32\x1f\xb8\x69\xf7\xde\x01\xd7\x8f\x3e\x70\xef\x6a\x84\xf0\x9d\x40\x17\x6e\x96\x1b\x37\xb7\x7f\x16\xfa\x55\xa7\x8b\x77\x23\xfd\x88\xb4\xfc\x89\xf9\xeb\x46\x0a\x39\x60\x02\x43\xf9\xc2\x10\x1e\xeb\xbf\x40\x49\xbd\x60\xee\xf9\xf4\x2f\x2f\x18\x8f\x24\xf1\xed\xcb\xff\x3b\x00\x09\xff\x84\x87\x4a\xa0\x00\x00")
 
 func staticReport_templateHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,7 +85,27 @@ func staticReport_templateHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/report_template.html", size: 31308, mode: os.FileMode(420), modTime: time.Unix(1558255764, 0)}
+	info := bindataFileInfo{name: "static/report_template.html", size: 41034, mode: os.FileMode(420), modTime: time.Unix(1792206043, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTakeover_signaturesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x58\x51\x6f\xdb\x38\x12\x7e\xcf\xaf\x98\xf3\x3d\xf4\x0e\x88\xa8\x38\xb1\x73\x4d\xb0\x58\x60\x93\x45\xdb\x5b\x5c\x17\x41\x93\x6e\x71\x28\xfa\x40\x91\x63\x89\x8d\x44\xea\xc8\x61\x1c\xf7\x70\xff\xfd\x40\x52\x76\x6c\xc7\x92\xbc\x6f\x36\xf9\x91\x33\xdf\xc7\x99\xe1\x50\x5f\x4f\x00\xfe\x7b\x02\x00\x30\xd1\xbc\xc1\xc9\x35\x4c\xde\x2b\xaa\x7c\x01\x77\xbc\x44\x37\x39\x4d\x73\x5c\x4a\xeb\x26\xd7\xf0\x75\x32\x7d\x3b\x67\xd3\xab\x2b\x36\x3d\x7b\xcb\xa6\xf3\x8b\xc9\x29\x6c\x0d\x5d\xbd\x1a\x9a\x9e\xbd\x1e\x9a\xc6\xa1\x6f\xdd\xd6\x0b\xa5\x4b\xb4\xad\x55\x9a\x92\x85\x87\x0a\x2d\x82\x72\xfa\x0d\x01\x87\xf7\x8a\x3e\xac\xbd\x01\xa7\x08\x21\x4c\xb3\xb0\xe3\x3b\x63\xc1\x1a\x43\xf0\xf9\xd3\xbf\x1c\xfc\xad\x56\x8f\x08\x15\x51\x7b\x9d\xe7\xf8\xcc\x9b\xb6\x46\x26\x4c\x93\xff\x1d\x56\xc6\x43\xe3\x1d\x41\x6b\xcd\x93\x92\x08\x5c\x83\xd2\x12\x9f\x59\x45\x4d\x0d\x0b\x55\xe3\xc6\x1d\x69\x84\x6f\x50\x13\x27\x65\x74\x90\x23\xec\xe8\xae\xf3\xbc\xc2\xba\x65\x65\xd4\x26\x6e\xcb\x2d\x29\x51\xa3\xcb\xbd\x53\xba\xcc\x78\x26\xbc\x23\xd3\x64\xd2\x34\x5c\xe9\x6c\xa9\xa8\xca\x12\x3c\x6b\x83\xf3\xf9\xe4\x04\xe0\x7f\xa7\xaf\xf5\xfe\xa5\xe1\x3f\x8c\x86\xfb\x8b\xb5\xd8\x22\xcc\x24\x2d\x18\x8f\x93\x7c\xe9\x82\xcd\x7e\xcd\x7e\x37\xf7\x5e\x54\x37\x5e\x3c\x22\x05\x69\x1e\x2a\x04\xd7\xa2\x50\x0b\x85\x12\x8a\x38\x0e\xd2\xa0\x03\x6d\x08\xf0\x59\x39\x1a\x67\x2c\x8d\x70\x2c\x98\x4e\x4e\x44\xd6\xc9\xd9\xfb\x8b\xbc\xe6\x84\x8e\x72\x89\x4f\xf9\x12\x8b\x70\x30\x59\x65\x1c\x05\x29\xf6\x84\xe0\xf5\x23\x55\xd6\xf8\xb2\x8a\x72\xf7\xc9\x70\xcb\x9b\x96\xab\x52\xc3\x47\xa3\x15\x19\xbb\x56\xa3\xdb\x7d\xdb\xb1\xe5\x72\xc9\x44\x07\x6f\x12\x3a\x3a\x77\x40\xc0\xf8\x93\x09\x8b\xc1\x5d\xd4\x72\x58\xc6\x5f\x8d\x2f\x6a\x04\x51\xa1\x78\x04\xaa\x30\x44\x16\x18\x0b\x47\x46\xc7\x21\x9f\x76\xc5\x48\x8e\xf5\x2a\x60\x4b\x03\xb7\xa6\xae\x51\x90\x7a\xc2\x01\x05\x44\x80\x8a\x0d\xb2\x8f\xbd\xf3\x45\x32\xcc\x0e\x2c\xe8\x97\x61\x76\x36\x83\xdf\x0d\xc1\x3b\xe3\xb5\x1c\xe7\xee\x7c\xdb\x1a\x4b\xec\xfc\x90\x95\xfc\x73\x97\x1e\x0f\x95\xb2\x32\xbb\xe3\x96\x56\xd9\xaf\xd1\xa9\x3e\x1d\xde\x21\xca\x3b\x8b\xce\x0d\x08\xb0\x40\x94\xac\x0d\xa0\x43\xbc\x2d\x4a\x65\x51\x10\x0b\xb0\x88\x62\x0d\x0e\x56\x1c\x08\x48\xa8\x78\x4a\x90\x02\x51\xc3\x22\xb0\x67\xc7\xd3\xdf\x72\xa9\xab\x0d\xf9\xe5\x34\xab\xcc\x32\x23\x93\xa5\x08\x7c\xa9\x12\x21\x57\x22\xdf\x1e\x0d\xde\x07\xc0\xa1\x82\x50\x86\x09\xa6\xcc\x30\x1b\xaa\x94\x2e\x63\xdd\x5b\xa2\x45\xa8\x8d\x79\x0c\x03\x0b\x63\x41\x05\x8a\x50\x9b\xb0\x2a\xd6\xd2\x53\x30\x16\x34\x3e\xa1\x85\x25\x77\x47\x96\x85\xe4\x86\xb1\x65\xbe\xe0\xff\xe9\x4a\xe0\x4e\xa8\xf7\xd7\xbb\x0f\x58\xb7\xdf\xbd\x12\x43\xf1\x5d\xad\x31\x7d\x91\xcd\x76\x10\xfd\x62\x7c\x41\x10\xc6\xd7\x32\x1e\xeb\x42\x69\x09\xcb\x8a\x53\x50\xe6\xcd\xae\x2e\xec\xc8\x1c\xdf\xf5\xec\x62\x76\x71\x71\x95\x95\x48\xb1\xf0\x39\xe2\x96\x50\xee\xe6\xfc\x90\x0c\xf7\xc2\x78\x1a\x29\x74\xc1\xa0\x0b\x38\xa6\x91\x7a\xa5\x88\x88\x78\x32\x23\xf7\x04\xb8\xe4\xac\x4b\x91\x11\x83\x3c\xc6\x05\x55\xca\x81\x30\x4d\xcb\xf5\xea\xfa\xc8\x28\xd8\xf5\x6d\x1d\xf5\xb3\xf3\xcc\x21\xf9\x36\x3b\x52\x07\x6b\x1e\xfd\xa8\x08\x01\x34\x10\x0c\x61\x5a\xea\xc4\xfe\x14\xd6\x23\xbc\x6d\xf7\x46\x9c\xab\xc7\x15\xf2\xa2\x02\xde\xb6\x1b\x90\x7e\xee\x28\x5c\x03\x59\x8f\xa3\xd2\xe0\x93\x40\x4d\x68\xb7\xdd\xde\xb4\x0b\xbb\x59\xd2\xa7\xca\x6f\x48\x37\x36\x02\x86\x85\xf9\x8e\x54\x44\x5c\xaf\x36\xcd\x6a\x07\xd3\xcf\x5c\xa5\xda\xc7\xc1\x62\xa9\x1c\xa1\x45\x09\xff\xd4\xb7\xb5\xf1\x12\xfe\x6d\xfc\x83\xe5\xe2\x71\x3c\x2e\x5e\x3b\x15\x82\x24\x5f\x19\x4f\x61\x83\x5c\x69\x11\x76\xcc\xd3\x15\x90\xdd\x77\xd1\x18\x5b\x83\xbf\x7a\x87\xd9\xeb\x0b\xb3\x4f\xa3\x8f\x4a\x58\xe3\xcc\x82\xe0\x97\x1f\xde\x0e\x95\x13\x1e\xe6\x59\xb3\xc6\xf7\x6a\x15\x71\xdd\x7a\x17\x62\x7a\xf8\x8e\xfc\x82\x05\xdc\x2b\xc2\x54\x5c\x76\x2e\xcb\x3f\x1b\x32\x21\x9b\x76\xfd\x43\x9d\x79\x97\x3c\xcf\x79\xdb\x66\x0e\xed\x93\x12\x3b\xbf\xb3\x25\x16\x19\x79\x32\x56\xf1\xfa\xb8\x6c\xfb\x84\x5c\x36\x43\x52\xd9\x08\x60\xca\x1c\x14\x68\x33\x1b\x53\x2a\xfd\x1b\x4d\xa9\x3b\x6b\xbe\xa3\x48\xfd\xa7\xee\xba\x4f\xc6\x18\xac\x90\xfe\x32\x1e\x50\x9d\xc9\x17\xbf\x82\x56\x79\x57\xc5\xb2\x63\xab\xcc\xbd\xb7\xe5\x10\x6d\x17\xe6\x99\xab\x0e\xb1\xd6\x3c\x5b\xa2\xa3\x29\x5b\x83\x26\xdf\x5e\x3d\x8b\x66\x73\x36\x9f\xa7\xe7\xce\xf9\xac\x5f\x8a\xb6\x93\xe2\x75\xc0\x0c\xb4\x17\x9d\x67\x31\x8f\xb8\x94\x07\x5e\x1b\x7d\xac\x1f\x7c\x53\xd4\xf6\x00\xa5\xb4\xcc\x31\x8a\x80\x9d\xe3\x7b\xe1\x74\x79\xc9\x2e\xd9\x6c\xc6\x06\xf8\x7c\xa9\x38\xc5\xce\xe1\x60\xab\x11\x0f\xfc\x0d\x81\xf0\xd6\xa2\xa6\x7a\x95\xce\x1e\x38\xa5\xdb\x26\x98\x42\x77\x44\xcb\xd1\xb9\xf9\x03\xb5\x44\xf7\x98\xaa\x8a\x58\x67\xc8\xba\xb0\x9e\x5f\x4c\xcf\xe7\x97\xf3\xd9\xdb\xec\xf6\xa8\x12\xfb\xd9\xa1\xfd\xc3\x0c\xf7\x21\xa1\x9a\xf9\x90\x6d\x66\xa8\x17\xd9\x41\x0c\x35\x66\xca\xc1\xc6\x2a\x6c\x9a\x73\x50\x6e\x4b\x22\xfe\xc4\x55\xcd\x8b\x1a\x8f\xc8\x0d\x89\x4f\x58\x9b\x16\xed\x9e\x93\x29\x45\x14\x61\xde\x95\x51\x5e\x2b\x1e\x7a\xb4\xde\xa6\xec\x8b\xb1\xa9\x51\x3e\xc4\x6f\xb9\x9e\x1c\x7b\x3d\xa5\x30\xe0\x9a\x80\xcc\xe6\x1a\x19\xa7\x81\x9a\xad\x9b\xe8\x1d\x53\xf9\xba\x95\x6c\x78\x9b\x6d\xe4\xea\xe5\x70\xdf\xf8\xf2\xa3\x2f\x47\x8e\xd3\x35\xbe\x6c\x7c\xd9\x77\x98\xeb\xcc\xd8\x82\x6d\x08\x60\xd3\xd2\xea\xc6\xc8\xd5\x91\x55\x3d\x36\x8c\xdb\xf6\xc2\xfd\xb6\xff\xa1\xe0\x66\xd5\x7c\xc4\xe7\xe5\x6f\x7f\x7c\x78\xdf\x4b\x8c\xac\x0a\x49\x55\xaf\xc6\xb8\x6d\x80\xbd\xb1\xea\xb6\x40\x72\xaf\x25\x78\x49\xfd\xf9\x8c\x4d\xdf\x5e\xb0\xe9\xd9\x39\x3b\x3f\xef\x3f\xf1\x1b\x4f\xa0\x16\xfb\xdd\x34\x19\x28\xbc\xaa\x65\x18\xb7\x60\x96\x1a\x3a\x77\x4f\x8f\x7f\x4c\xed\x31\x39\x90\xed\xd3\xf9\xd9\xec\xf2\x6a\xf6\x8f\xec\xd6\x68\x8d\x82\xd6\x59\x3f\xfc\xb6\xfc\xdc\x92\x6a\xf0\x93\x29\xcc\x50\xdf\xed\x23\xca\x06\x54\xef\xd3\x9a\x38\x39\xb6\x07\x1c\xc9\xfd\xd6\x17\xb5\x12\x10\x96\x7a\x07\xe1\xab\x10\xfc\x54\xfc\xbc\xf9\x2c\xe3\x10\x1b\x20\x93\x8a\xe4\x4f\x79\xf1\xf3\x11\x6f\x92\xa2\x36\xe5\xbe\x17\xb9\xd2\x64\x8d\xf4\x22\xdc\x14\xc9\x66\x96\x6c\xa6\x2f\x51\xd9\x8a\xaf\x7a\x93\xe8\x8e\x6b\xaa\xd0\xe8\x01\x75\xda\x0e\xd2\xd7\x24\xac\xe7\xc3\xba\xd1\x97\x6a\x69\xa4\x03\x6e\x11\x96\xca\x1d\xf1\x15\x6e\xdb\x76\xac\x72\x3b\xaf\xcd\x93\x6f\x27\xff\x1f\x00\xd6\x50\x41\xee\xd1\x14\x00\x00")
+
+func staticTakeover_signaturesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTakeover_signaturesJson,
+		"static/takeover_signatures.json",
+	)
+}
+
+func staticTakeover_signaturesJson() (*asset, error) {
+	bytes, err := staticTakeover_signaturesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/takeover_signatures.json", size: 5329, mode: os.FileMode(420), modTime: time.Unix(1792203139, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -162,6 +183,7 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"static/report_template.html": staticReport_templateHtml,
+	"static/takeover_signatures.json": staticTakeover_signaturesJson,
 	"static/wappalyzer_fingerprints.json": staticWappalyzer_fingerprintsJson,
 }
 
@@ -207,6 +229,7 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"static": &bintree{nil, map[string]*bintree{
 		"report_template.html": &bintree{staticReport_templateHtml, map[string]*bintree{}},
+		"takeover_signatures.json": &bintree{staticTakeover_signaturesJson, map[string]*bintree{}},
 		"wappalyzer_fingerprints.json": &bintree{staticWappalyzer_fingerprintsJson, map[string]*bintree{}},
 	}},
 }}
@@ -0,0 +1,23 @@
+package core
+
+import (
+	"sync/atomic"
+
+	"github.com/asaskevich/EventBus"
+)
+
+// countingBus counts published events, so that the session can tell whether
+// new work was queued while it was waiting for agents.
+type countingBus struct {
+	EventBus.Bus
+	published uint64
+}
+
+func (b *countingBus) Publish(topic string, args ...interface{}) {
+	atomic.AddUint64(&b.published, 1)
+	b.Bus.Publish(topic, args...)
+}
+
+func (b *countingBus) Published() uint64 {
+	return atomic.LoadUint64(&b.published)
+}
@@ -0,0 +1,225 @@
+package core
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Number of consecutive structure tokens hashed together.
+	shingleSize = 3
+	// MinHash signatures are split into bands of rows for locality
+	// sensitive hashing. Pages that agree on all rows of at least one band
+	// become candidates for the same cluster.
+	minHashBands = 32
+	minHashRows  = 4
+	minHashSize  = minHashBands * minHashRows
+	// Screenshot hashes are split into bands of 8 bits, so hashes that
+	// differ in less than 8 bits always share a band.
+	visualBands = 8
+)
+
+var minHashSeeds = func() []uint64 {
+	seeds := make([]uint64, minHashSize)
+	for i := range seeds {
+		seeds[i] = mix64(uint64(i) + 1)
+	}
+	return seeds
+}()
+
+type pageSignature struct {
+	minHash   []uint64
+	visual    uint64
+	hasVisual bool
+}
+
+// ClusterPages groups similar pages into s.PageSimilarityClusters. Pages are
+// visited in URL order and each page joins the cluster whose first page is
+// the most similar to it, if that similarity reaches threshold. Only
+// clusters found through locality sensitive hashing are compared, which
+// keeps clustering close to linear in the number of pages. Cluster IDs are
+// derived from the URL of the first page, so the same input gives the same
+// clusters.
+func (s *Session) ClusterPages(threshold float64) {
+	urls := make([]string, 0, len(s.Pages))
+	for url := range s.Pages {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	useVisual := *s.Options.ClusterMode != "structure"
+	algorithm := *s.Options.VisualHash
+
+	var leaders []*pageSignature
+	var leaderClusters []string
+	structureBuckets := make(map[uint64][]int)
+	visualBuckets := make(map[uint64][]int)
+	clusters := make(map[string][]string)
+
+	for _, url := range urls {
+		signature := newPageSignature(s.Pages[url], useVisual, algorithm)
+
+		candidates := make(map[int]struct{})
+		structureKeys := signature.structureBucketKeys()
+		for _, key := range structureKeys {
+			for _, i := range structureBuckets[key] {
+				candidates[i] = struct{}{}
+			}
+		}
+		var visualKeys []uint64
+		if signature.hasVisual {
+			visualKeys = signature.visualBucketKeys()
+			for _, key := range visualKeys {
+				for _, i := range visualBuckets[key] {
+					candidates[i] = struct{}{}
+				}
+			}
+		}
+
+		best := -1
+		bestSimilarity := threshold
+		for i := range candidates {
+			similarity := s.signatureSimilarity(signature, leaders[i])
+			if similarity > bestSimilarity || (similarity == bestSimilarity && (best == -1 || i < best)) {
+				best = i
+				bestSimilarity = similarity
+			}
+		}
+
+		if best != -1 {
+			clusterID := leaderClusters[best]
+			clusters[clusterID] = append(clusters[clusterID], url)
+			continue
+		}
+
+		clusterID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(url)).String()
+		clusters[clusterID] = []string{url}
+		leaders = append(leaders, signature)
+		leaderClusters = append(leaderClusters, clusterID)
+		for _, key := range structureKeys {
+			structureBuckets[key] = append(structureBuckets[key], len(leaders)-1)
+		}
+		for _, key := range visualKeys {
+			visualBuckets[key] = append(visualBuckets[key], len(leaders)-1)
+		}
+	}
+
+	s.PageSimilarityClusters = clusters
+}
+
+// signatureSimilarity returns the similarity of two pages between 0 and 1
+// using the clustering mode of the session: structure compares HTML
+// structure, visual compares screenshot hashes and combined weighs both.
+// Pages without screenshot hashes are compared by structure only.
+func (s *Session) signatureSimilarity(a *pageSignature, b *pageSignature) float64 {
+	structural := minHashSimilarity(a.minHash, b.minHash)
+	if !a.hasVisual || !b.hasVisual {
+		return structural
+	}
+
+	visual := 1 - float64(bits.OnesCount64(a.visual^b.visual))/64
+	if *s.Options.ClusterMode == "visual" {
+		return visual
+	}
+
+	structureWeight := *s.Options.StructureWeight
+	visualWeight := *s.Options.VisualWeight
+	return (structureWeight*structural + visualWeight*visual) / (structureWeight + visualWeight)
+}
+
+func newPageSignature(page *Page, useVisual bool, algorithm string) *pageSignature {
+	signature := &pageSignature{
+		minHash: minHash(shingles(page.PageStructure)),
+	}
+
+	if useVisual && page.ScreenshotHash != nil {
+		if visual, err := strconv.ParseUint(page.ScreenshotHash.Get(algorithm), 16, 64); err == nil {
+			signature.visual = visual
+			signature.hasVisual = true
+		}
+	}
+
+	return signature
+}
+
+func (p *pageSignature) structureBucketKeys() []uint64 {
+	keys := make([]uint64, minHashBands)
+	for band := 0; band < minHashBands; band++ {
+		key := mix64(uint64(band) + 1)
+		for _, value := range p.minHash[band*minHashRows : (band+1)*minHashRows] {
+			key = mix64(key ^ value)
+		}
+		keys[band] = key
+	}
+	return keys
+}
+
+func (p *pageSignature) visualBucketKeys() []uint64 {
+	keys := make([]uint64, visualBands)
+	for band := 0; band < visualBands; band++ {
+		keys[band] = uint64(band)<<8 | (p.visual>>(uint(band)*8))&0xff
+	}
+	return keys
+}
+
+// shingles hashes every run of shingleSize consecutive tokens. Structures
+// shorter than that are hashed as a whole.
+func shingles(structure []string) []uint64 {
+	if len(structure) == 0 {
+		return nil
+	}
+
+	size := shingleSize
+	if len(structure) < size {
+		size = len(structure)
+	}
+
+	hashes := make([]uint64, 0, len(structure)-size+1)
+	for i := 0; i+size <= len(structure); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(structure[i:i+size], "\x00")))
+		hashes = append(hashes, h.Sum64())
+	}
+	return hashes
+}
+
+func minHash(shingles []uint64) []uint64 {
+	signature := make([]uint64, minHashSize)
+	for i := range signature {
+		signature[i] = ^uint64(0)
+	}
+	for _, shingle := range shingles {
+		for i, seed := range minHashSeeds {
+			if h := mix64(shingle ^ seed); h < signature[i] {
+				signature[i] = h
+			}
+		}
+	}
+	return signature
+}
+
+// minHashSimilarity estimates the Jaccard similarity of the shingle sets of
+// two pages.
+func minHashSimilarity(a []uint64, b []uint64) float64 {
+	equal := 0
+	for i := range a {
+		if a[i] == b[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a))
+}
+
+// mix64 is the SplitMix64 finalizer, a fast and well distributed hash of a
+// 64 bit value.
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
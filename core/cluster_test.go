@@ -0,0 +1,165 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func testStructure(prefix string, n int) []string {
+	structure := make([]string, n)
+	for i := range structure {
+		structure[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	return structure
+}
+
+func testClusterSession(mode string, pages map[string]*Page) *Session {
+	visualHash := "phash"
+	structureWeight := 0.5
+	visualWeight := 0.5
+	options := Options{
+		ClusterMode:     &mode,
+		VisualHash:      &visualHash,
+		StructureWeight: &structureWeight,
+		VisualWeight:    &visualWeight,
+	}
+	return &Session{Options: options, Pages: pages}
+}
+
+// clusterGroups returns the URLs of every cluster, sorted, so that clusters
+// can be compared without their IDs.
+func clusterGroups(clusters map[string][]string) [][]string {
+	var groups [][]string
+	for _, urls := range clusters {
+		group := append([]string(nil), urls...)
+		sort.Strings(group)
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+	return groups
+}
+
+func TestClusterPages(t *testing.T) {
+	login := testStructure("login", 40)
+	loginVariant := append(append([]string(nil), login...), "extra")
+	blog := testStructure("blog", 40)
+	white := &ImageHash{AHash: "0000000000000000", DHash: "0000000000000000", PHash: "0000000000000000"}
+	black := &ImageHash{AHash: "ffffffffffffffff", DHash: "ffffffffffffffff", PHash: "ffffffffffffffff"}
+
+	tests := []struct {
+		name  string
+		mode  string
+		pages map[string]*Page
+		want  [][]string
+	}{
+		{
+			name: "structure",
+			mode: "structure",
+			pages: map[string]*Page{
+				"http://a/": {PageStructure: login},
+				"http://b/": {PageStructure: loginVariant},
+				"http://c/": {PageStructure: blog},
+			},
+			want: [][]string{{"http://a/", "http://b/"}, {"http://c/"}},
+		},
+		{
+			name: "structure ignores screenshots",
+			mode: "structure",
+			pages: map[string]*Page{
+				"http://a/": {PageStructure: login, ScreenshotHash: white},
+				"http://b/": {PageStructure: login, ScreenshotHash: black},
+			},
+			want: [][]string{{"http://a/", "http://b/"}},
+		},
+		{
+			name: "visual",
+			mode: "visual",
+			pages: map[string]*Page{
+				"http://a/": {PageStructure: login, ScreenshotHash: white},
+				"http://b/": {PageStructure: login, ScreenshotHash: black},
+				"http://c/": {PageStructure: blog, ScreenshotHash: white},
+			},
+			want: [][]string{{"http://a/", "http://c/"}, {"http://b/"}},
+		},
+		{
+			name: "visual without screenshots",
+			mode: "visual",
+			pages: map[string]*Page{
+				"http://a/": {PageStructure: login},
+				"http://b/": {PageStructure: login, ScreenshotHash: black},
+				"http://c/": {PageStructure: blog},
+			},
+			want: [][]string{{"http://a/", "http://b/"}, {"http://c/"}},
+		},
+		{
+			name: "combined",
+			mode: "combined",
+			pages: map[string]*Page{
+				"http://a/": {PageStructure: login, ScreenshotHash: white},
+				"http://b/": {PageStructure: login, ScreenshotHash: black},
+				"http://c/": {PageStructure: loginVariant, ScreenshotHash: white},
+			},
+			want: [][]string{{"http://a/", "http://c/"}, {"http://b/"}},
+		},
+		{
+			name: "empty structures",
+			mode: "structure",
+			pages: map[string]*Page{
+				"http://a/": {},
+				"http://b/": {},
+			},
+			want: [][]string{{"http://a/", "http://b/"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testClusterSession(tt.mode, tt.pages)
+			s.ClusterPages(0.8)
+			if got := clusterGroups(s.PageSimilarityClusters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clusters = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterPagesDeterministic(t *testing.T) {
+	pages := map[string]*Page{
+		"http://a/": {PageStructure: testStructure("login", 40)},
+		"http://b/": {PageStructure: testStructure("login", 40)},
+		"http://c/": {PageStructure: testStructure("blog", 40)},
+	}
+	first := testClusterSession("structure", pages)
+	first.ClusterPages(0.8)
+	second := testClusterSession("structure", pages)
+	second.ClusterPages(0.8)
+	if !reflect.DeepEqual(first.PageSimilarityClusters, second.PageSimilarityClusters) {
+		t.Errorf("clusters differ between runs: %v and %v", first.PageSimilarityClusters, second.PageSimilarityClusters)
+	}
+}
+
+func TestMinHashSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		min  float64
+		max  float64
+	}{
+		{"equal", testStructure("a", 50), testStructure("a", 50), 1, 1},
+		{"disjoint", testStructure("a", 50), testStructure("b", 50), 0, 0.1},
+		{"half", testStructure("a", 52), testStructure("a", 27), 0.3, 0.7},
+		{"short", []string{"html"}, []string{"html"}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := minHashSimilarity(minHash(shingles(tt.a)), minHash(shingles(tt.b)))
+			if got < tt.min || got > tt.max {
+				t.Errorf("similarity = %v, want between %v and %v", got, tt.min, tt.max)
+			}
+		})
+	}
+}
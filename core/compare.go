@@ -0,0 +1,275 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// VisualChangeThreshold is the smallest screenshot difference, between 0 and
+// 1, that is reported as a visual change.
+const VisualChangeThreshold = 0.05
+
+const screenshotSampleSize = 32
+
+// Headers that change on every request and would make every page look
+// changed.
+var volatileHeaders = map[string]struct{}{
+	"age":              {},
+	"cf-ray":           {},
+	"content-length":   {},
+	"date":             {},
+	"etag":             {},
+	"expires":          {},
+	"last-modified":    {},
+	"set-cookie":       {},
+	"x-request-id":     {},
+	"x-runtime":        {},
+	"x-amz-cf-id":      {},
+	"x-amz-request-id": {},
+}
+
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+type PageChanges struct {
+	URL     string   `json:"url"`
+	Changes []Change `json:"changes"`
+}
+
+type SessionDiff struct {
+	ComparedTo   string        `json:"comparedTo"`
+	OldStartedAt time.Time     `json:"oldStartedAt"`
+	NewURLs      []string      `json:"newUrls"`
+	RemovedURLs  []string      `json:"removedUrls"`
+	ChangedPages []PageChanges `json:"changedPages"`
+}
+
+func (d *SessionDiff) HasChanges() bool {
+	return len(d.NewURLs) > 0 || len(d.RemovedURLs) > 0 || len(d.ChangedPages) > 0
+}
+
+type comparison struct {
+	path        string
+	startedAt   time.Time
+	pages       map[string]*Page
+	screenshots map[string][]uint8
+}
+
+// LoadComparison loads a previous session file to compare the current scan
+// against. Screenshots of the previous session are sampled right away, since
+// the current scan may overwrite them when it writes to the same directory.
+func (s *Session) LoadComparison(filePath string) error {
+	jsonSession, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	c := &comparison{
+		path:        filePath,
+		screenshots: make(map[string][]uint8),
+	}
+	var parsedSession Session
+	if err := json.Unmarshal(jsonSession, &parsedSession); err != nil {
+		return err
+	}
+	c.pages = parsedSession.Pages
+	if parsedSession.Stats != nil {
+		c.startedAt = parsedSession.Stats.StartedAt
+	}
+
+	baseDir := filepath.Dir(filePath)
+	for url, page := range c.pages {
+		if !page.HasScreenshot || page.ScreenshotPath == "" {
+			continue
+		}
+		sample, err := sampleScreenshot(filepath.Join(baseDir, page.ScreenshotPath))
+		if err != nil {
+			continue
+		}
+		c.screenshots[url] = sample
+	}
+
+	s.comparison = c
+	return nil
+}
+
+// Compare matches pages of the current session with the session loaded with
+// LoadComparison by URL and stores the differences in s.Diff.
+func (s *Session) Compare() *SessionDiff {
+	if s.comparison == nil {
+		return nil
+	}
+	diff := &SessionDiff{
+		ComparedTo:   s.comparison.path,
+		OldStartedAt: s.comparison.startedAt,
+	}
+
+	for url, page := range s.Pages {
+		oldPage, ok := s.comparison.pages[url]
+		if !ok {
+			diff.NewURLs = append(diff.NewURLs, url)
+			continue
+		}
+
+		changes := comparePages(oldPage, page)
+		if change, ok := s.compareScreenshots(url, page); ok {
+			changes = append(changes, change)
+		}
+		if len(changes) > 0 {
+			diff.ChangedPages = append(diff.ChangedPages, PageChanges{
+				URL:     url,
+				Changes: changes,
+			})
+		}
+	}
+
+	for url := range s.comparison.pages {
+		if _, ok := s.Pages[url]; !ok {
+			diff.RemovedURLs = append(diff.RemovedURLs, url)
+		}
+	}
+
+	sort.Strings(diff.NewURLs)
+	sort.Strings(diff.RemovedURLs)
+	sort.Slice(diff.ChangedPages, func(i, j int) bool {
+		return diff.ChangedPages[i].URL < diff.ChangedPages[j].URL
+	})
+
+	s.Diff = diff
+	return diff
+}
+
+func (s *Session) compareScreenshots(url string, page *Page) (Change, bool) {
+	oldSample, ok := s.comparison.screenshots[url]
+	if !ok || !page.HasScreenshot {
+		return Change{}, false
+	}
+	newSample, err := sampleScreenshot(s.GetFilePath(page.ScreenshotPath))
+	if err != nil {
+		return Change{}, false
+	}
+
+	difference := sampleDifference(oldSample, newSample)
+	if difference < VisualChangeThreshold {
+		return Change{}, false
+	}
+	return Change{
+		Field: "screenshot",
+		New:   fmt.Sprintf("%.0f%% different", difference*100),
+	}, true
+}
+
+func comparePages(oldPage *Page, newPage *Page) []Change {
+	var changes []Change
+	if oldPage.Status != newPage.Status {
+		changes = append(changes, Change{Field: "status", Old: oldPage.Status, New: newPage.Status})
+	}
+	if oldPage.PageTitle != newPage.PageTitle {
+		changes = append(changes, Change{Field: "title", Old: oldPage.PageTitle, New: newPage.PageTitle})
+	}
+
+	oldTags := make(map[string]string)
+	newTags := make(map[string]string)
+	for _, tag := range oldPage.Tags {
+		oldTags[tag.Text] = tag.Type
+	}
+	for _, tag := range newPage.Tags {
+		newTags[tag.Text] = tag.Type
+	}
+	for _, tag := range sortedKeys(newTags) {
+		if _, ok := oldTags[tag]; !ok {
+			changes = append(changes, Change{Field: "tag", New: tag})
+		}
+	}
+	for _, tag := range sortedKeys(oldTags) {
+		if _, ok := newTags[tag]; !ok {
+			changes = append(changes, Change{Field: "tag", Old: tag})
+		}
+	}
+
+	oldHeaders := headerValues(oldPage.Headers)
+	newHeaders := headerValues(newPage.Headers)
+	for _, name := range sortedKeys(newHeaders) {
+		if oldHeaders[name] != newHeaders[name] {
+			changes = append(changes, Change{Field: "header " + name, Old: oldHeaders[name], New: newHeaders[name]})
+		}
+	}
+	for _, name := range sortedKeys(oldHeaders) {
+		if _, ok := newHeaders[name]; !ok {
+			changes = append(changes, Change{Field: "header " + name, Old: oldHeaders[name]})
+		}
+	}
+
+	return changes
+}
+
+func headerValues(headers []Header) map[string]string {
+	values := make(map[string]string)
+	for _, header := range headers {
+		name := strings.ToLower(header.Name)
+		if _, ok := volatileHeaders[name]; ok {
+			continue
+		}
+		values[name] = header.Value
+	}
+	return values
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sampleScreenshot scales a screenshot down to a small grayscale image that
+// is cheap to keep in memory and compare.
+func sampleScreenshot(filePath string) ([]uint8, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() < screenshotSampleSize || bounds.Dy() < screenshotSampleSize {
+		return nil, fmt.Errorf("screenshot is too small")
+	}
+
+	sample := make([]uint8, screenshotSampleSize*screenshotSampleSize)
+	for i, p := range resizeGray(img, screenshotSampleSize, screenshotSampleSize) {
+		sample[i] = uint8(p)
+	}
+
+	return sample, nil
+}
+
+// sampleDifference returns the mean difference of two samples between 0
+// (identical) and 1.
+func sampleDifference(a []uint8, b []uint8) float64 {
+	var total uint64
+	for i := range a {
+		if a[i] > b[i] {
+			total += uint64(a[i] - b[i])
+		} else {
+			total += uint64(b[i] - a[i])
+		}
+	}
+	return float64(total) / float64(len(a)*255)
+}
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const previousSession = `{
+  "stats": {"startedAt": "2024-05-01T10:00:00Z"},
+  "pages": {
+    "http://kept.example.com/": {
+      "url": "http://kept.example.com/",
+      "status": "200 OK",
+      "pageTitle": "Login",
+      "headers": [
+        {"name": "Server", "value": "nginx/1.18"},
+        {"name": "Date", "value": "Wed, 01 May 2024 10:00:00 GMT"}
+      ],
+      "tags": [{"text": "nginx", "type": "info"}, {"text": "PHP", "type": "info"}],
+      "hasScreenshot": true,
+      "screenshotPath": "screenshots/kept.png"
+    },
+    "http://same.example.com/": {
+      "url": "http://same.example.com/",
+      "status": "200 OK",
+      "pageTitle": "Home",
+      "hasScreenshot": true,
+      "screenshotPath": "screenshots/same.png"
+    },
+    "http://gone.example.com/": {
+      "url": "http://gone.example.com/",
+      "status": "404 Not Found"
+    }
+  }
+}`
+
+// writeScreenshot writes a PNG screenshot filled with a single gray level.
+func writeScreenshot(t *testing.T, filePath string, gray uint8) {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, 64, 48))
+	for i := range img.Pix {
+		img.Pix[i] = gray
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	oldDir := t.TempDir()
+	sessionPath := filepath.Join(oldDir, "aquatone_session.json")
+	if err := os.WriteFile(sessionPath, []byte(previousSession), 0644); err != nil {
+		t.Fatal(err)
+	}
+	writeScreenshot(t, filepath.Join(oldDir, "screenshots/kept.png"), 255)
+	writeScreenshot(t, filepath.Join(oldDir, "screenshots/same.png"), 128)
+
+	newDir := t.TempDir()
+	writeScreenshot(t, filepath.Join(newDir, "screenshots/kept.png"), 0)
+	writeScreenshot(t, filepath.Join(newDir, "screenshots/same.png"), 130)
+
+	s := &Session{
+		Options: Options{OutDir: &newDir},
+		Pages: map[string]*Page{
+			"http://kept.example.com/": {
+				URL:       "http://kept.example.com/",
+				Status:    "403 Forbidden",
+				PageTitle: "Login",
+				Headers: []Header{
+					{Name: "server", Value: "nginx/1.24"},
+					{Name: "Date", Value: "Thu, 02 May 2024 10:00:00 GMT"},
+					{Name: "X-Frame-Options", Value: "DENY"},
+				},
+				Tags:           []Tag{{Text: "nginx", Type: "info"}, {Text: "WordPress", Type: "info"}},
+				HasScreenshot:  true,
+				ScreenshotPath: "screenshots/kept.png",
+			},
+			"http://same.example.com/": {
+				URL:            "http://same.example.com/",
+				Status:         "200 OK",
+				PageTitle:      "Home",
+				HasScreenshot:  true,
+				ScreenshotPath: "screenshots/same.png",
+			},
+			"http://new.example.com/": {URL: "http://new.example.com/", Status: "200 OK"},
+		},
+	}
+
+	if s.Compare() != nil {
+		t.Fatal("Compare() without a loaded comparison returned a diff")
+	}
+	if err := s.LoadComparison(sessionPath); err != nil {
+		t.Fatal(err)
+	}
+	// The new scan may overwrite screenshots of the previous one.
+	writeScreenshot(t, filepath.Join(oldDir, "screenshots/kept.png"), 0)
+
+	diff := s.Compare()
+	if diff != s.Diff || !diff.HasChanges() {
+		t.Fatalf("unexpected diff %+v", diff)
+	}
+	if diff.OldStartedAt.Format("2006-01-02") != "2024-05-01" {
+		t.Errorf("OldStartedAt = %s", diff.OldStartedAt)
+	}
+	if !reflect.DeepEqual(diff.NewURLs, []string{"http://new.example.com/"}) {
+		t.Errorf("NewURLs = %v", diff.NewURLs)
+	}
+	if !reflect.DeepEqual(diff.RemovedURLs, []string{"http://gone.example.com/"}) {
+		t.Errorf("RemovedURLs = %v", diff.RemovedURLs)
+	}
+	if len(diff.ChangedPages) != 1 || diff.ChangedPages[0].URL != "http://kept.example.com/" {
+		t.Fatalf("ChangedPages = %+v", diff.ChangedPages)
+	}
+
+	want := []Change{
+		{Field: "status", Old: "200 OK", New: "403 Forbidden"},
+		{Field: "tag", New: "WordPress"},
+		{Field: "tag", Old: "PHP"},
+		{Field: "header server", Old: "nginx/1.18", New: "nginx/1.24"},
+		{Field: "header x-frame-options", New: "DENY"},
+		{Field: "screenshot", New: "100% different"},
+	}
+	if got := diff.ChangedPages[0].Changes; !reflect.DeepEqual(got, want) {
+		t.Errorf("changes = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestSampleScreenshotTooSmall(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "tiny.png")
+	f, err := os.Create(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	img.Set(0, 0, color.White)
+	png.Encode(f, img)
+	f.Close()
+
+	if _, err := sampleScreenshot(filePath); err == nil {
+		t.Error("sampleScreenshot() accepted a 16x16 screenshot")
+	}
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CookieJar holds the cookies loaded with -cookies.
+type CookieJar struct {
+	Cookies []*http.Cookie
+	jar     *cookiejar.Jar
+}
+
+func LoadCookieJar(filePath string) (*CookieJar, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseCookieJar(f)
+}
+
+// ParseCookieJar parses cookies in the Netscape cookies.txt format written by
+// curl, wget and browser extensions. Cookies that match a host and all of its
+// subdomains get a Domain starting with a dot. Expired cookies are skipped.
+func ParseCookieJar(r io.Reader) (*CookieJar, error) {
+	jar, _ := cookiejar.New(nil)
+	cookieJar := &CookieJar{jar: jar}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		httpOnly := false
+		if strings.HasPrefix(line, "#HttpOnly_") {
+			httpOnly = true
+			line = strings.TrimPrefix(line, "#HttpOnly_")
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("line %d: expected 7 tab separated fields", lineNumber)
+		}
+		expires, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid expiry %s", lineNumber, fields[4])
+		}
+
+		domain := strings.TrimPrefix(strings.ToLower(fields[0]), ".")
+		cookie := &http.Cookie{
+			Name:     fields[5],
+			Value:    fields[6],
+			Path:     fields[2],
+			Secure:   strings.EqualFold(fields[3], "TRUE"),
+			HttpOnly: httpOnly,
+		}
+		if strings.EqualFold(fields[1], "TRUE") {
+			cookie.Domain = "." + domain
+		}
+		if expires > 0 {
+			cookie.Expires = time.Unix(expires, 0)
+			if cookie.Expires.Before(time.Now()) {
+				continue
+			}
+		}
+
+		scheme := "http"
+		if cookie.Secure {
+			scheme = "https"
+		}
+		jar.SetCookies(&url.URL{Scheme: scheme, Host: domain, Path: "/"}, []*http.Cookie{cookie})
+		if cookie.Domain == "" {
+			cookie.Domain = domain
+		}
+		cookieJar.Cookies = append(cookieJar.Cookies, cookie)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return cookieJar, nil
+}
+
+// CookiesFor returns the cookies to send with a request to u.
+func (j *CookieJar) CookiesFor(u *url.URL) []*http.Cookie {
+	if j == nil {
+		return nil
+	}
+	return j.jar.Cookies(u)
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCookieJar(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour).Unix()
+	past := time.Now().Add(-24 * time.Hour).Unix()
+	jar := strings.Join([]string{
+		"# Netscape HTTP Cookie File",
+		"",
+		fmt.Sprintf(".example.com\tTRUE\t/\tFALSE\t%d\tsession\tabc", future),
+		"app.example.org\tFALSE\t/admin\tTRUE\t0\tadmin\tdef",
+		fmt.Sprintf("#HttpOnly_example.net\tFALSE\t/\tFALSE\t%d\ttoken\tghi", future),
+		fmt.Sprintf("example.com\tFALSE\t/\tFALSE\t%d\texpired\tjkl", past),
+	}, "\n")
+
+	cookieJar, err := ParseCookieJar(strings.NewReader(jar))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cookieJar.Cookies) != 3 {
+		t.Fatalf("got %d cookies, want 3", len(cookieJar.Cookies))
+	}
+	if c := cookieJar.Cookies[0]; c.Domain != ".example.com" || c.Name != "session" || c.Value != "abc" || c.Expires.Unix() != future {
+		t.Errorf("unexpected subdomain cookie %+v", c)
+	}
+	if c := cookieJar.Cookies[1]; c.Domain != "app.example.org" || c.Path != "/admin" || !c.Secure || !c.Expires.IsZero() {
+		t.Errorf("unexpected secure session cookie %+v", c)
+	}
+	if c := cookieJar.Cookies[2]; c.Domain != "example.net" || !c.HttpOnly {
+		t.Errorf("unexpected HttpOnly cookie %+v", c)
+	}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"http://example.com/", "session"},
+		{"http://www.example.com/page", "session"},
+		{"https://app.example.org/admin/users", "admin"},
+		{"http://app.example.org/admin/", ""},
+		{"https://app.example.org/", ""},
+		{"https://sub.app.example.org/admin/", ""},
+		{"http://example.net/", "token"},
+		{"http://www.example.net/", ""},
+		{"http://example.org/", ""},
+	}
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.url)
+		var names []string
+		for _, c := range cookieJar.CookiesFor(u) {
+			names = append(names, c.Name)
+		}
+		if got := strings.Join(names, ","); got != tt.want {
+			t.Errorf("CookiesFor(%s) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestParseCookieJarErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		jar  string
+	}{
+		{"too few fields", "example.com\tFALSE\t/\tFALSE\t0\tname"},
+		{"spaces instead of tabs", "example.com FALSE / FALSE 0 name value"},
+		{"invalid expiry", "example.com\tFALSE\t/\tFALSE\tnever\tname\tvalue"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCookieJar(strings.NewReader(tt.jar)); err == nil {
+				t.Errorf("ParseCookieJar(%q) returned no error", tt.jar)
+			}
+		})
+	}
+}
+
+func TestNilCookieJar(t *testing.T) {
+	var cookieJar *CookieJar
+	u, _ := url.Parse("http://example.com/")
+	if cookies := cookieJar.CookiesFor(u); cookies != nil {
+		t.Errorf("nil jar returned cookies %v", cookies)
+	}
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ParseHeader parses a "Name: value" header given with -header.
+func ParseHeader(s string) (string, string, error) {
+	i := strings.Index(s, ":")
+	if i == -1 {
+		return "", "", fmt.Errorf("Invalid header %s, expected Name: value", s)
+	}
+	name := strings.TrimSpace(s[:i])
+	if name == "" || strings.ContainsAny(name, " \t") {
+		return "", "", fmt.Errorf("Invalid header name in %s", s)
+	}
+	return http.CanonicalHeaderKey(name), strings.TrimSpace(s[i+1:]), nil
+}
+
+// Credential is an Authorization header sent to the hosts matching a
+// pattern, given with -auth as pattern=basic:user:password or
+// pattern=bearer:token. Patterns use the syntax of scope file rules.
+type Credential struct {
+	rule          scopeRule
+	authorization string
+}
+
+func ParseCredential(s string) (*Credential, error) {
+	i := strings.Index(s, "=")
+	if i == -1 {
+		return nil, fmt.Errorf("Invalid credentials %s, expected pattern=basic:user:password or pattern=bearer:token", s)
+	}
+	pattern := strings.TrimSpace(s[:i])
+	rule, err := parseScopeRule(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid credentials pattern %s: %s", pattern, err)
+	}
+
+	credential := &Credential{rule: rule}
+	parts := strings.SplitN(s[i+1:], ":", 2)
+	kind, value := parts[0], ""
+	if len(parts) == 2 {
+		value = parts[1]
+	}
+	switch strings.ToLower(kind) {
+	case "basic":
+		if !strings.Contains(value, ":") {
+			return nil, fmt.Errorf("Invalid basic credentials for %s, expected basic:user:password", pattern)
+		}
+		credential.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(value))
+	case "bearer":
+		if value == "" {
+			return nil, fmt.Errorf("Invalid bearer credentials for %s, expected bearer:token", pattern)
+		}
+		credential.authorization = "Bearer " + value
+	default:
+		return nil, fmt.Errorf("Invalid credentials type %s for %s, expected basic or bearer", kind, pattern)
+	}
+
+	return credential, nil
+}
+
+// Authorization returns the value of the Authorization header.
+func (c *Credential) Authorization() string {
+	return c.authorization
+}
+
+// Matches reports whether the credential is sent to u.
+func (c *Credential) Matches(u *url.URL) bool {
+	if c.rule.pattern != nil {
+		return c.rule.pattern.MatchString(u.String())
+	}
+	_, port, _ := net.SplitHostPort(URLAddr(u))
+	p, _ := strconv.Atoi(port)
+	return c.rule.matchesHost(normalizeScopeHost(u.Hostname())) && c.rule.matchesPort(p)
+}
+
+// Authorization returns the Authorization header of the first credentials
+// given with -auth that match rawURL, or an empty string.
+func (s *Session) Authorization(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	for _, credential := range s.Credentials {
+		if credential.Matches(u) {
+			return credential.Authorization()
+		}
+	}
+	return ""
+}
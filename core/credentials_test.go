@@ -0,0 +1,100 @@
+package core
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestParseCredential(t *testing.T) {
+	tests := []struct {
+		credential    string
+		authorization string
+		matches       []string
+		misses        []string
+	}{
+		{
+			credential:    "*.corp.local=basic:admin:secret",
+			authorization: "Basic YWRtaW46c2VjcmV0",
+			matches:       []string{"http://app.corp.local/", "https://APP.corp.local:8443/x"},
+			misses:        []string{"http://corp.local/", "http://app.corp.local.evil.com/"},
+		},
+		{
+			credential:    "api.example.com:443=bearer:token=with:colons",
+			authorization: "Bearer token=with:colons",
+			matches:       []string{"https://api.example.com/v1"},
+			misses:        []string{"http://api.example.com/", "https://api.example.com:8443/"},
+		},
+		{
+			credential:    "10.0.0.0/24=BASIC:user:p:ss",
+			authorization: "Basic dXNlcjpwOnNz",
+			matches:       []string{"http://10.0.0.7:8080/"},
+			misses:        []string{"http://10.0.1.7/"},
+		},
+		{
+			credential:    "re:^https://example\\.com/api/=bearer:abc",
+			authorization: "Bearer abc",
+			matches:       []string{"https://example.com/api/users"},
+			misses:        []string{"https://example.com/", "http://example.com/api/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.credential, func(t *testing.T) {
+			credential, err := ParseCredential(tt.credential)
+			if err != nil {
+				t.Fatalf("ParseCredential(%q) returned error: %s", tt.credential, err)
+			}
+			if got := credential.Authorization(); got != tt.authorization {
+				t.Errorf("Authorization() = %q, want %q", got, tt.authorization)
+			}
+			for _, rawURL := range tt.matches {
+				u, _ := url.Parse(rawURL)
+				if !credential.Matches(u) {
+					t.Errorf("credential doesn't match %s", rawURL)
+				}
+			}
+			for _, rawURL := range tt.misses {
+				u, _ := url.Parse(rawURL)
+				if credential.Matches(u) {
+					t.Errorf("credential matches %s", rawURL)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCredentialErrors(t *testing.T) {
+	tests := []string{
+		"example.com",
+		"example.com=basic:admin",
+		"example.com=basic",
+		"example.com=bearer:",
+		"example.com=digest:admin:secret",
+		"foo*bar=bearer:abc",
+		"=bearer:abc",
+	}
+	for _, credential := range tests {
+		if _, err := ParseCredential(credential); err == nil {
+			t.Errorf("ParseCredential(%q) returned no error", credential)
+		}
+	}
+}
+
+func TestSessionAuthorization(t *testing.T) {
+	first, _ := ParseCredential("*.example.com=bearer:first")
+	second, _ := ParseCredential("*=bearer:second")
+	s := &Session{Credentials: []*Credential{first, second}}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://www.example.com/", "Bearer first"},
+		{"https://example.org/", "Bearer second"},
+		{"://invalid", ""},
+	}
+	for _, tt := range tests {
+		if got := s.Authorization(tt.url); got != tt.want {
+			t.Errorf("Authorization(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
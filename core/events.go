@@ -3,8 +3,10 @@ package core
 const (
 	SessionStart  = "session:start"
 	SessionEnd    = "session:end"
+	SessionAbort  = "session:abort"
 	Host          = "host"
 	URL           = "url"
 	URLResponsive = "url:responsive"
+	URLProcessed  = "url:processed"
 	TCPPort       = "port:tcp"
 )
@@ -0,0 +1,177 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+
+	_ "golang.org/x/image/webp"
+)
+
+// ImageHash holds perceptual hashes of a screenshot. Similar looking images
+// have hashes with a small Hamming distance. Hashes are hex encoded, as 64 bit
+// integers don't survive the trip through JSON into JavaScript.
+type ImageHash struct {
+	AHash string `json:"aHash"`
+	DHash string `json:"dHash"`
+	PHash string `json:"pHash"`
+}
+
+// Get returns the hash computed with algorithm: ahash, dhash or phash.
+func (h *ImageHash) Get(algorithm string) string {
+	switch algorithm {
+	case "ahash":
+		return h.AHash
+	case "dhash":
+		return h.DHash
+	}
+	return h.PHash
+}
+
+// NewImageHash decodes a PNG, JPEG or WebP image and computes its hashes.
+func NewImageHash(data []byte) (*ImageHash, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImageHash{
+		AHash: fmt.Sprintf("%016x", averageHash(img)),
+		DHash: fmt.Sprintf("%016x", differenceHash(img)),
+		PHash: fmt.Sprintf("%016x", perceptualHash(img)),
+	}, nil
+}
+
+// HashSimilarity returns the similarity of two hex encoded hashes between 0
+// (every bit differs) and 1 (identical).
+func HashSimilarity(a string, b string) (float64, error) {
+	x, err := strconv.ParseUint(a, 16, 64)
+	if err != nil {
+		return 0, err
+	}
+	y, err := strconv.ParseUint(b, 16, 64)
+	if err != nil {
+		return 0, err
+	}
+	return 1 - float64(bits.OnesCount64(x^y))/64, nil
+}
+
+func averageHash(img image.Image) uint64 {
+	pixels := resizeGray(img, 8, 8)
+	var mean float64
+	for _, p := range pixels {
+		mean += p
+	}
+	mean /= float64(len(pixels))
+
+	var hash uint64
+	for i, p := range pixels {
+		if p > mean {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+func differenceHash(img image.Image) uint64 {
+	pixels := resizeGray(img, 9, 8)
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if pixels[y*9+x] > pixels[y*9+x+1] {
+				hash |= 1 << uint(y*8+x)
+			}
+		}
+	}
+	return hash
+}
+
+// perceptualHash compares the low frequency DCT coefficients of a 32x32
+// version of the image with their median.
+func perceptualHash(img image.Image) uint64 {
+	const size = 32
+	pixels := resizeGray(img, size, size)
+
+	cosines := make([]float64, size*size)
+	for u := 0; u < size; u++ {
+		for x := 0; x < size; x++ {
+			cosines[u*size+x] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / (2 * size))
+		}
+	}
+
+	rows := make([]float64, size*size)
+	for y := 0; y < size; y++ {
+		for u := 0; u < 8; u++ {
+			var sum float64
+			for x := 0; x < size; x++ {
+				sum += pixels[y*size+x] * cosines[u*size+x]
+			}
+			rows[y*size+u] = sum
+		}
+	}
+
+	coefficients := make([]float64, 64)
+	for v := 0; v < 8; v++ {
+		for u := 0; u < 8; u++ {
+			var sum float64
+			for y := 0; y < size; y++ {
+				sum += rows[y*size+u] * cosines[v*size+y]
+			}
+			coefficients[v*8+u] = sum
+		}
+	}
+
+	// The DC coefficient only reflects overall brightness.
+	sorted := append([]float64(nil), coefficients[1:]...)
+	sort.Float64s(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+
+	var hash uint64
+	for i, c := range coefficients {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// resizeGray scales an image down to width x height grayscale values between
+// 0 and 255 by averaging the pixels covered by each cell. Large images are
+// sampled on a sparse grid within each cell.
+func resizeGray(img image.Image, width int, height int) []float64 {
+	bounds := img.Bounds()
+	pixels := make([]float64, width*height)
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+			stepX := (x1-x0)/8 + 1
+			stepY := (y1-y0)/8 + 1
+			var sum float64
+			var count int
+			for py := y0; py < y1; py += stepY {
+				for px := x0; px < x1; px += stepX {
+					r, g, b, _ := img.At(px, py).RGBA()
+					sum += (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 257
+					count++
+				}
+			}
+			pixels[y*width+x] = sum / float64(count)
+		}
+	}
+	return pixels
+}
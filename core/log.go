@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/fatih/color"
@@ -40,6 +41,11 @@ type Logger struct {
 	noColor bool
 
 	writer io.Writer
+
+	status       string
+	statusWriter io.Writer
+	statusShown  bool
+	midLine      bool
 }
 
 func NewLogger(writer io.Writer, debug, silent, noColor bool) *Logger {
@@ -70,6 +76,37 @@ func (l *Logger) SetWriter(w io.Writer) {
 	l.writer = w
 }
 
+// SetStatus shows line as a status line on w, below the log output. Log
+// messages clear the status line and draw it again after them. An empty line
+// removes the status line.
+func (l *Logger) SetStatus(w io.Writer, line string) {
+	l.Lock()
+	defer l.Unlock()
+	if l.silent {
+		return
+	}
+	l.clearStatus()
+	l.status = line
+	l.statusWriter = w
+	l.drawStatus()
+}
+
+func (l *Logger) clearStatus() {
+	if l.statusShown {
+		fmt.Fprint(l.statusWriter, "\r\033[K")
+		l.statusShown = false
+	}
+}
+
+// drawStatus draws the status line unless a message without a trailing
+// newline was logged last, which the status line would be appended to.
+func (l *Logger) drawStatus() {
+	if l.status != "" && !l.midLine {
+		fmt.Fprint(l.statusWriter, l.status)
+		l.statusShown = true
+	}
+}
+
 func (l *Logger) Log(level int, format string, args ...interface{}) {
 	l.Lock()
 	defer l.Unlock()
@@ -79,6 +116,10 @@ func (l *Logger) Log(level int, format string, args ...interface{}) {
 		return
 	}
 
+	l.clearStatus()
+	l.midLine = !strings.HasSuffix(format, "\n")
+	defer l.drawStatus()
+
 	if c, ok := LogColors[level]; ok && !l.noColor {
 		_, err := c.Fprintf(l.writer, format, args...)
 		if err != nil {
@@ -90,10 +131,6 @@ func (l *Logger) Log(level int, format string, args ...interface{}) {
 			fmt.Printf(format, args...)
 		}
 	}
-
-	if level == FATAL {
-		os.Exit(1)
-	}
 }
 
 func (l *Logger) Fatal(format string, args ...interface{}) {
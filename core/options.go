@@ -3,53 +3,198 @@ package core
 import (
 	"flag"
 	"fmt"
+	"io"
 	"strings"
 )
 
+// StringList is the value of a flag that can be given more than once.
+type StringList []string
+
+func (l *StringList) String() string {
+	return strings.Join(*l, ", ")
+}
+
+func (l *StringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func stringList(fs *flag.FlagSet, name string, usage string) *StringList {
+	l := &StringList{}
+	fs.Var(l, name, usage)
+	return l
+}
+
 type Options struct {
-	Threads           *int
-	OutDir            *string
-	OutFile           *string
-	SessionPath       *string
-	TemplatePath      *string
-	Proxy             *string
-	ChromePath        *string
-	Resolution        *string
-	Ports             *string
-	ScanTimeout       *int
-	HTTPTimeout       *int
-	ScreenshotTimeout *int
-	Nmap              *bool
-	SaveBody          *bool
-	Silent            *bool
-	Debug             *bool
-	Version           *bool
-	Tar               *bool
+	Threads             *int
+	OutDir              *string
+	OutFile             *string
+	SessionPath         *string
+	Resume              *bool
+	Compare             *string
+	CheckpointInterval  *int
+	Progress            *bool
+	ProgressInterval    *int
+	TemplatePath        *string
+	Proxy               *string
+	Headers             *StringList
+	Cookies             *string
+	Auth                *StringList
+	NoSpoofHeaders      *bool
+	Paths               *string
+	PathMethods         *string
+	Soft404Threshold    *float64
+	VHosts              *string
+	VHostsFromSession   *bool
+	VHostThreshold      *float64
+	ChromePath          *string
+	Resolution          *string
+	Ports               *string
+	ScanTimeout         *int
+	HostConnections     *int
+	BannerTimeout       *int
+	HTTPTimeout         *int
+	ScreenshotTimeout   *int
+	ScreenshotWait      *string
+	ScreenshotDelay     *int
+	ScreenshotSelector  *string
+	ScreenshotElement   *string
+	ScreenshotFormat    *string
+	ScreenshotQuality   *int
+	FullPage            *bool
+	ThumbnailWidth      *int
+	TakeoverSignatures  *string
+	PublishRedirects    *bool
+	PublishCertHosts    *bool
+	OutputJSONL         *string
+	Scope               *string
+	RateLimit           *float64
+	HostRateLimit       *float64
+	Jitter              *int
+	MaxRangeSize        *int
+	InputFormat         *string
+	CSVHostColumn       *string
+	CSVPortColumn       *string
+	CSVSchemeColumn     *string
+	ClusterMode         *string
+	VisualHash          *string
+	StructureWeight     *float64
+	VisualWeight        *float64
+	SimilarityThreshold *float64
+	Agents              *string
+	DisableAgents       *string
+	ExternalAgents      *string
+	AgentTimeout        *int
+	Nmap                *bool
+	NmapAllPorts        *bool
+	SaveBody            *bool
+	Silent              *bool
+	Debug               *bool
+	Version             *bool
+	Tar                 *bool
 }
 
-func ParseOptions() (Options, error) {
-	options := Options{
-		Threads:           flag.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
-		OutDir:            flag.String("out", ".", "Directory to write files to"),
-		OutFile:           flag.String("out-file", "", "Directory to write files to"),
-		SessionPath:       flag.String("session", "", "Load Aquatone session file and generate HTML report"),
-		TemplatePath:      flag.String("template-path", "", "Path to HTML template to use for report"),
-		Proxy:             flag.String("proxy", "", "Proxy to use for HTTP requests"),
-		ChromePath:        flag.String("chrome-path", "", "Full path to the Chrome/Chromium executable to use. By default, aquatone will search for Chrome or Chromium"),
-		Resolution:        flag.String("resolution", "1440,900", "screenshot resolution"),
-		Ports:             flag.String("ports", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]"), "Ports to scan on hosts. Supported list aliases: small, medium, large, xlarge"),
-		ScanTimeout:       flag.Int("scan-timeout", 500, "Timeout in miliseconds for port scans"),
-		HTTPTimeout:       flag.Int("http-timeout", 3*1000, "Timeout in miliseconds for HTTP requests"),
-		ScreenshotTimeout: flag.Int("screenshot-timeout", 30*1000, "Timeout in miliseconds for screenshots"),
-		Nmap:              flag.Bool("nmap", false, "Parse input as Nmap/Masscan XML"),
-		SaveBody:          flag.Bool("save-body", true, "Save response bodies to files"),
-		Silent:            flag.Bool("silent", false, "Suppress all output except for errors"),
-		Debug:             flag.Bool("debug", false, "Print debugging information"),
-		Version:           flag.Bool("version", false, "Print current Aquatone version"),
-		Tar:               flag.Bool("tar", false, "Pack report to .tar.gz archive"),
+// DefaultOptions returns options set to the default value of every command
+// line flag, for running scans from Go code without parsing flags.
+func DefaultOptions() Options {
+	return defineOptions(flag.NewFlagSet(Name, flag.ContinueOnError))
+}
+
+// ParseOptionValues sets options by name, such as
+// map[string][]string{"ports": {"small"}}, without parsing command line
+// arguments. Options that can be given multiple times take several values.
+func ParseOptionValues(values map[string][]string) (Options, error) {
+	fs := flag.NewFlagSet(Name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	options := defineOptions(fs)
+	for name, vals := range values {
+		if fs.Lookup(name) == nil {
+			return Options{}, fmt.Errorf("Unknown option %s", name)
+		}
+		for _, v := range vals {
+			if err := fs.Set(name, v); err != nil {
+				return Options{}, fmt.Errorf("Invalid value %q for option %s: %s", v, name, err)
+			}
+		}
 	}
+	return options, nil
+}
+
+func ParseOptions() (Options, error) {
+	options := defineOptions(flag.CommandLine)
 
 	flag.Parse()
 
 	return options, nil
 }
+
+func defineOptions(fs *flag.FlagSet) Options {
+	return Options{
+		Threads:             fs.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
+		OutDir:              fs.String("out", ".", "Directory to write files to"),
+		OutFile:             fs.String("out-file", "", "Directory to write files to"),
+		SessionPath:         fs.String("session", "", "Load Aquatone session file and generate HTML report"),
+		Resume:              fs.Bool("resume", false, "Resume an interrupted scan from session file given with -session (default aquatone_session.json in output directory)"),
+		Compare:             fs.String("compare", "", "Compare results with a previous Aquatone session file and report changes"),
+		CheckpointInterval:  fs.Int("checkpoint-interval", 60, "Interval in seconds between session file checkpoints (0 to disable)"),
+		Progress:            fs.Bool("progress", false, "Show progress of port scans, requests, screenshots and fingerprinting with rates and ETA"),
+		ProgressInterval:    fs.Int("progress-interval", 10, "Interval in seconds between progress lines when output is not a terminal (0 to disable)"),
+		TemplatePath:        fs.String("template-path", "", "Path to HTML template to use for report"),
+		Proxy:               fs.String("proxy", "", "Proxy to use for HTTP requests"),
+		Headers:             stringList(fs, "header", "Header to send with HTTP requests and screenshots, as Name: value (can be given multiple times)"),
+		Cookies:             fs.String("cookies", "", "Path to cookie jar file in Netscape cookies.txt format to send cookies from"),
+		Auth:                stringList(fs, "auth", "Credentials for hosts matching a scope pattern, as pattern=basic:user:password or pattern=bearer:token (can be given multiple times)"),
+		NoSpoofHeaders:      fs.Bool("no-spoof-headers", false, "Don't send random X-Forwarded-For, Via and Forwarded headers"),
+		Paths:               fs.String("paths", "", "Path to wordlist of paths to probe on every responsive base URL"),
+		PathMethods:         fs.String("path-methods", "GET", "Comma separated list of HTTP methods to probe paths with"),
+		Soft404Threshold:    fs.Float64("soft-404-threshold", 0.9, "Minimum similarity (0-1) to the response for a random path for a probed path to be treated as not found"),
+		VHosts:              fs.String("vhosts", "", "Path to list of hostnames to try as virtual hosts on IP targets"),
+		VHostsFromSession:   fs.Bool("vhosts-from-session", false, "Try hostnames of scanned pages and certificate SANs as virtual hosts on IP targets"),
+		VHostThreshold:      fs.Float64("vhost-threshold", 0.9, "Minimum similarity (0-1) of responses for a virtual host to be treated as a duplicate"),
+		ChromePath:          fs.String("chrome-path", "", "Full path to the Chrome/Chromium executable to use. By default, aquatone will search for Chrome or Chromium"),
+		Resolution:          fs.String("resolution", "1440,900", "screenshot resolution"),
+		Ports:               fs.String("ports", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]"), "Ports and port ranges (1-1024) to scan on hosts. Supported list aliases: small, medium, large, xlarge"),
+		ScanTimeout:         fs.Int("scan-timeout", 500, "Maximum timeout in miliseconds for port scans, shortened per host from measured round-trip times"),
+		BannerTimeout:       fs.Int("banner-timeout", 1000, "Timeout in miliseconds to wait for banners of non-web services on open ports (0 to treat every open port as HTTP)"),
+		HostConnections:     fs.Int("host-connections", 16, "Maximum number of concurrent port scan connections to a single host (0 for unlimited)"),
+		HTTPTimeout:         fs.Int("http-timeout", 3*1000, "Timeout in miliseconds for HTTP requests"),
+		ScreenshotTimeout:   fs.Int("screenshot-timeout", 30*1000, "Timeout in miliseconds for screenshots"),
+		ScreenshotWait:      fs.String("screenshot-wait", "load", "Page event to wait for before taking screenshots: load, domcontentloaded, networkidle"),
+		ScreenshotDelay:     fs.Int("screenshot-delay", 0, "Additional delay in miliseconds before taking screenshots"),
+		ScreenshotSelector:  fs.String("screenshot-selector", "", "CSS selector of an element to wait for before taking screenshots"),
+		ScreenshotElement:   fs.String("screenshot-element", "", "CSS selector of an element to capture instead of the viewport"),
+		ScreenshotFormat:    fs.String("screenshot-format", "png", "Image format for screenshots: png, jpeg, webp"),
+		ScreenshotQuality:   fs.Int("screenshot-quality", 80, "Image quality (1-100) for jpeg and webp screenshots"),
+		FullPage:            fs.Bool("full-page", false, "Capture the full scrollable page instead of the viewport"),
+		ThumbnailWidth:      fs.Int("thumbnail-width", 600, "Width in pixels of screenshot thumbnails used in the HTML report (0 to disable)"),
+		TakeoverSignatures:  fs.String("takeover-signatures", "", "Path to JSON file with domain takeover signatures (default built-in signatures)"),
+		PublishRedirects:    fs.Bool("publish-redirects", false, "Process final URL of redirects leaving the original host as a new URL"),
+		PublishCertHosts:    fs.Bool("publish-cert-hosts", false, "Process hostnames found in TLS certificate SANs as new hosts"),
+		OutputJSONL:         fs.String("output-jsonl", "", "Write each processed page as a JSON line to this file"),
+		Scope:               fs.String("scope", "", "File with scope rules; targets outside of scope are skipped"),
+		RateLimit:           fs.Float64("rate-limit", 0, "Maximum number of connections per second to all targets (0 for unlimited)"),
+		HostRateLimit:       fs.Float64("host-rate-limit", 0, "Maximum number of connections per second to a single host or IP (0 for unlimited)"),
+		Jitter:              fs.Int("jitter", 0, "Maximum random delay in miliseconds added before each connection"),
+		MaxRangeSize:        fs.Int("max-range-size", 65536, "Maximum number of hosts a single CIDR block or IP range in input may expand to"),
+		InputFormat:         fs.String("input-format", "auto", "Input format: auto, text, nmap, masscan, nessus, csv"),
+		CSVHostColumn:       fs.String("csv-host-column", "host", "Name or 1-based index of the host column in CSV input"),
+		CSVPortColumn:       fs.String("csv-port-column", "port", "Name or 1-based index of the port column in CSV input (empty to scan ports)"),
+		CSVSchemeColumn:     fs.String("csv-scheme-column", "scheme", "Name or 1-based index of the scheme column in CSV input (empty to detect)"),
+		ClusterMode:         fs.String("cluster-mode", "structure", "How to cluster similar pages: structure (HTML), visual (screenshots) or combined"),
+		VisualHash:          fs.String("visual-hash", "phash", "Perceptual hash used to compare screenshots: ahash, dhash, phash"),
+		StructureWeight:     fs.Float64("structure-weight", 0.5, "Weight of HTML structure similarity in combined clustering mode"),
+		VisualWeight:        fs.Float64("visual-weight", 0.5, "Weight of screenshot similarity in combined clustering mode"),
+		SimilarityThreshold: fs.Float64("similarity-threshold", 0.80, "Minimum similarity (0-1) for pages to be clustered together"),
+		Agents:              fs.String("agents", "", "Comma separated list of agents to enable (default all)"),
+		DisableAgents:       fs.String("disable-agents", "", "Comma separated list of agents to disable"),
+		ExternalAgents:      fs.String("external-agents", "", "Comma separated list of executables to run as external agents"),
+		AgentTimeout:        fs.Int("agent-timeout", 30*1000, "Timeout in miliseconds for external agents to process a page"),
+		Nmap:                fs.Bool("nmap", false, "Parse input as Nmap/Masscan XML (same as -input-format nmap)"),
+		NmapAllPorts:        fs.Bool("nmap-all-ports", false, "Probe every open port from Nmap XML input, not only web services"),
+		SaveBody:            fs.Bool("save-body", true, "Save response bodies to files"),
+		Silent:              fs.Bool("silent", false, "Suppress all output except for errors"),
+		Debug:               fs.Bool("debug", false, "Print debugging information"),
+		Version:             fs.Bool("version", false, "Print current Aquatone version"),
+		Tar:                 fs.Bool("tar", false, "Pack report to .tar.gz archive"),
+	}
+}
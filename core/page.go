@@ -2,12 +2,16 @@ package core
 
 import (
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 )
@@ -90,6 +94,13 @@ func (t Tag) HasLink() bool {
 	return false
 }
 
+type Redirect struct {
+	URL      string   `json:"url"`
+	Status   string   `json:"status"`
+	Location string   `json:"location"`
+	Headers  []Header `json:"headers"`
+}
+
 type Note struct {
 	Text string `json:"text"`
 	Type string `json:"type"`
@@ -97,20 +108,27 @@ type Note struct {
 
 type Page struct {
 	sync.Mutex
-	UUID           string   `json:"uuid"`
-	URL            string   `json:"url"`
-	Hostname       string   `json:"hostname"`
-	Addrs          []string `json:"addrs"`
-	Status         string   `json:"status"`
-	PageTitle      string   `json:"pageTitle"`
-	PageStructure  []string `json:"-"`
-	HeadersPath    string   `json:"headersPath"`
-	BodyPath       string   `json:"bodyPath"`
-	ScreenshotPath string   `json:"screenshotPath"`
-	HasScreenshot  bool     `json:"hasScreenshot"`
-	Headers        []Header `json:"headers"`
-	Tags           []Tag    `json:"tags"`
-	Notes          []Note   `json:"notes"`
+	UUID           string     `json:"uuid"`
+	URL            string     `json:"url"`
+	Hostname       string     `json:"hostname"`
+	Addrs          []string   `json:"addrs"`
+	VirtualHostOf  string     `json:"virtualHostOf,omitempty"`
+	Status         string     `json:"status"`
+	PageTitle      string     `json:"pageTitle"`
+	PageStructure  []string   `json:"-"`
+	HeadersPath    string     `json:"headersPath"`
+	BodyPath       string     `json:"bodyPath"`
+	ScreenshotPath string     `json:"screenshotPath"`
+	ThumbnailPath  string     `json:"thumbnailPath"`
+	HasScreenshot  bool       `json:"hasScreenshot"`
+	ScreenshotHash *ImageHash `json:"screenshotHash"`
+	Headers        []Header   `json:"headers"`
+	FinalURL       string     `json:"finalUrl"`
+	Redirects      []Redirect `json:"redirects"`
+	TLS            *TLSInfo   `json:"tls"`
+	Tags           []Tag      `json:"tags"`
+	Notes          []Note     `json:"notes"`
+	tasks          int32
 }
 
 func (p *Page) AddHeader(name string, value string) {
@@ -124,6 +142,25 @@ func (p *Page) AddHeader(name string, value string) {
 	p.Headers = append(p.Headers, header)
 }
 
+func (p *Page) AddRedirect(url string, status string, location string, headers http.Header) {
+	p.Lock()
+	defer p.Unlock()
+	redirect := Redirect{
+		URL:      url,
+		Status:   status,
+		Location: location,
+	}
+	for name, value := range headers {
+		header := Header{
+			Name:  name,
+			Value: strings.Join(value, " "),
+		}
+		header.SetSecurityFlags()
+		redirect.Headers = append(redirect.Headers, header)
+	}
+	p.Redirects = append(p.Redirects, redirect)
+}
+
 func (p *Page) AddTag(text string, tagType string, link string) {
 	p.Lock()
 	defer p.Unlock()
@@ -150,6 +187,93 @@ func (p *Page) AddNote(text string, noteType string) {
 	})
 }
 
+// SetResponse records the status of the response to the page and the URL it
+// ended up at after redirects.
+func (p *Page) SetResponse(status string, finalURL string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Status = status
+	p.FinalURL = finalURL
+}
+
+func (p *Page) SetVirtualHostOf(addr string) {
+	p.Lock()
+	defer p.Unlock()
+	p.VirtualHostOf = addr
+}
+
+func (p *Page) SetAddrs(addrs []string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Addrs = addrs
+}
+
+func (p *Page) SetPageTitle(title string) {
+	p.Lock()
+	defer p.Unlock()
+	p.PageTitle = title
+}
+
+func (p *Page) SetHeadersPath(path string) {
+	p.Lock()
+	defer p.Unlock()
+	p.HeadersPath = path
+}
+
+func (p *Page) SetBodyPath(path string) {
+	p.Lock()
+	defer p.Unlock()
+	p.BodyPath = path
+}
+
+func (p *Page) SetTLS(info *TLSInfo) {
+	p.Lock()
+	defer p.Unlock()
+	p.TLS = info
+}
+
+// SetScreenshot records the screenshot of the page, its thumbnail if one was
+// written and its perceptual hash if it could be computed.
+func (p *Page) SetScreenshot(path string, thumbnailPath string, hash *ImageHash) {
+	p.Lock()
+	defer p.Unlock()
+	p.ScreenshotPath = path
+	p.ThumbnailPath = thumbnailPath
+	p.ScreenshotHash = hash
+	p.HasScreenshot = true
+}
+
+// StatusCode returns the numeric HTTP status code of the page or 0 if the
+// page has no valid status.
+func (p *Page) StatusCode() int {
+	code, err := strconv.Atoi(strings.SplitN(p.Status, " ", 2)[0])
+	if err != nil {
+		return 0
+	}
+	return code
+}
+
+func (p *Page) AddTasks(n int) {
+	atomic.AddInt32(&p.tasks, int32(n))
+}
+
+func (p *Page) doneTask() bool {
+	return atomic.AddInt32(&p.tasks, -1) == 0
+}
+
+func (p *Page) ToJSON() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// MarshalJSON locks the page while it is encoded, so that session checkpoints
+// and the API can encode pages that agents are still working on.
+func (p *Page) MarshalJSON() ([]byte, error) {
+	type page Page
+	p.Lock()
+	defer p.Unlock()
+	return json.Marshal((*page)(p))
+}
+
 func (p *Page) BaseFilename() string {
 	u := p.ParsedURL()
 	h := sha1.New()
@@ -167,6 +291,20 @@ func (p *Page) ParsedURL() *url.URL {
 	return parsedURL
 }
 
+// hostPort returns the host and port of the page URL, using the default port
+// of the scheme if the URL has none.
+func (p *Page) hostPort() string {
+	u := p.ParsedURL()
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(strings.ToLower(u.Hostname()), port)
+}
+
 func (p *Page) IsIPHost() bool {
 	return net.ParseIP(p.ParsedURL().Hostname()) != nil
 }
@@ -1,5 +1,11 @@
 package core
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 var (
 	SmallPortList = []int{80, 443}
 
@@ -17,3 +23,91 @@ var (
 		9090, 9091, 9200, 9443, 9800, 9981, 12443, 16080, 18091, 18092,
 		20720, 28017}
 )
+
+// PortState is the result of a port scan.
+type PortState string
+
+const (
+	// PortOpen ports accepted a connection.
+	PortOpen PortState = "open"
+	// PortClosed ports refused the connection.
+	PortClosed PortState = "closed"
+	// PortFiltered ports didn't answer, or answered with an ICMP error.
+	PortFiltered PortState = "filtered"
+	// PortUnreachable ports couldn't be connected to because there is no
+	// route to the host or its network.
+	PortUnreachable PortState = "unreachable"
+)
+
+// ParsePorts parses a port list alias (small, medium, large, xlarge) or a
+// comma separated list of ports and ranges such as "80,443,8000-8100".
+// Duplicate ports are scanned once.
+func ParsePorts(s string) ([]int, error) {
+	switch s {
+	case "small":
+		return SmallPortList, nil
+	case "", "medium", "default":
+		return MediumPortList, nil
+	case "large":
+		return LargePortList, nil
+	case "xlarge", "huge":
+		return XLargePortList, nil
+	}
+
+	ranges, err := parsePortRanges(s)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid port list %s: %s", s, err)
+	}
+	var ports []int
+	seen := make(map[int]bool)
+	for _, r := range ranges {
+		for port := r.from; port <= r.to; port++ {
+			if !seen[port] {
+				seen[port] = true
+				ports = append(ports, port)
+			}
+		}
+	}
+	return ports, nil
+}
+
+// FormatPorts returns ports as a comma separated list with consecutive ports
+// joined into ranges, for example "22, 80-90, 443".
+func FormatPorts(ports []int) string {
+	var parts []string
+	for i := 0; i < len(ports); {
+		j := i
+		for j+1 < len(ports) && ports[j+1] == ports[j]+1 {
+			j++
+		}
+		if j > i {
+			parts = append(parts, fmt.Sprintf("%d-%d", ports[i], ports[j]))
+		} else {
+			parts = append(parts, strconv.Itoa(ports[i]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SetPortState records the state of port on host found by the port scanner.
+func (s *Session) SetPortState(host string, port int, state PortState) {
+	host = strings.ToLower(host)
+	s.Lock()
+	defer s.Unlock()
+	if s.PortStates == nil {
+		s.PortStates = make(map[string]map[int]PortState)
+	}
+	if s.PortStates[host] == nil {
+		s.PortStates[host] = make(map[int]PortState)
+	}
+	s.PortStates[host][port] = state
+}
+
+// PortState returns the state of port on host, or an empty string if it
+// wasn't scanned.
+func (s *Session) PortState(host string, port int) PortState {
+	s.Lock()
+	defer s.Unlock()
+	return s.PortStates[strings.ToLower(host)][port]
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePorts(t *testing.T) {
+	tests := []struct {
+		ports string
+		want  []int
+	}{
+		{"small", SmallPortList},
+		{"", MediumPortList},
+		{"medium", MediumPortList},
+		{"default", MediumPortList},
+		{"large", LargePortList},
+		{"xlarge", XLargePortList},
+		{"huge", XLargePortList},
+		{"80", []int{80}},
+		{"443,80", []int{443, 80}},
+		{"8000-8003", []int{8000, 8001, 8002, 8003}},
+		{"80, 8080-8081 ,443", []int{80, 8080, 8081, 443}},
+		{"80,79-81,80", []int{80, 79, 81}},
+		{"1,65535", []int{1, 65535}},
+	}
+	for _, tt := range tests {
+		got, err := ParsePorts(tt.ports)
+		if err != nil {
+			t.Errorf("ParsePorts(%q) returned error: %s", tt.ports, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePorts(%q) = %v, want %v", tt.ports, got, tt.want)
+		}
+	}
+}
+
+func TestParsePortsErrors(t *testing.T) {
+	tests := []string{
+		"http",
+		"0",
+		"65536",
+		"80,",
+		"90-80",
+		"80-",
+		"-80",
+		"1-70000",
+	}
+	for _, ports := range tests {
+		if got, err := ParsePorts(ports); err == nil {
+			t.Errorf("ParsePorts(%q) = %v, want error", ports, got)
+		}
+	}
+}
+
+func TestFormatPorts(t *testing.T) {
+	tests := []struct {
+		ports []int
+		want  string
+	}{
+		{nil, ""},
+		{[]int{80}, "80"},
+		{[]int{80, 443}, "80, 443"},
+		{[]int{22, 80, 81, 82, 443}, "22, 80-82, 443"},
+		{[]int{8001, 8000}, "8001, 8000"},
+	}
+	for _, tt := range tests {
+		if got := FormatPorts(tt.ports); got != tt.want {
+			t.Errorf("FormatPorts(%v) = %q, want %q", tt.ports, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Stage is a step of the scan that is tracked by the progress display.
+type Stage int
+
+const (
+	StagePorts Stage = iota
+	StageRequests
+	StageScreenshots
+	StageFingerprints
+	stageCount
+)
+
+var stageNames = [stageCount]string{"ports", "requests", "screenshots", "fingerprints"}
+
+func (st Stage) String() string {
+	return stageNames[st]
+}
+
+// Stages returns all tracked stages in the order tasks go through them.
+func Stages() []Stage {
+	stages := make([]Stage, stageCount)
+	for i := range stages {
+		stages[i] = Stage(i)
+	}
+	return stages
+}
+
+// StageStats holds the task counters of a stage. Queued tasks wait for a free
+// thread, in-flight tasks are running.
+type StageStats struct {
+	Queued    uint32    `json:"queued"`
+	InFlight  uint32    `json:"inFlight"`
+	Done      uint32    `json:"done"`
+	StartedAt time.Time `json:"startedAt,omitempty"`
+}
+
+func (st StageStats) Total() uint32 {
+	return st.Queued + st.InFlight + st.Done
+}
+
+// Rate returns the number of tasks done per second since the first task of
+// the stage started.
+func (st StageStats) Rate(now time.Time) float64 {
+	elapsed := now.Sub(st.StartedAt).Seconds()
+	if st.StartedAt.IsZero() || elapsed <= 0 {
+		return 0
+	}
+	return float64(st.Done) / elapsed
+}
+
+// ETA estimates the time needed to finish the tasks known so far. It is zero
+// when nothing is left or the rate is not known yet.
+func (st StageStats) ETA(now time.Time) time.Duration {
+	rate := st.Rate(now)
+	remaining := st.Queued + st.InFlight
+	if remaining == 0 || rate == 0 {
+		return 0
+	}
+	return time.Duration(float64(remaining) / rate * float64(time.Second))
+}
+
+type stageCounters struct {
+	queued    uint32
+	inFlight  uint32
+	done      uint32
+	startedAt int64
+}
+
+// QueueTasks adds n tasks waiting to run in stage.
+func (s *Stats) QueueTasks(stage Stage, n int) {
+	atomic.AddUint32(&s.stages[stage].queued, uint32(n))
+}
+
+// DropTasks removes n queued tasks of stage that will not run.
+func (s *Stats) DropTasks(stage Stage, n int) {
+	atomic.AddUint32(&s.stages[stage].queued, ^uint32(n-1))
+}
+
+// StartTask moves a queued task of stage to in-flight.
+func (s *Stats) StartTask(stage Stage) {
+	c := &s.stages[stage]
+	atomic.CompareAndSwapInt64(&c.startedAt, 0, time.Now().UnixNano())
+	atomic.AddUint32(&c.queued, ^uint32(0))
+	atomic.AddUint32(&c.inFlight, 1)
+}
+
+// FinishTask moves an in-flight task of stage to done.
+func (s *Stats) FinishTask(stage Stage) {
+	c := &s.stages[stage]
+	atomic.AddUint32(&c.inFlight, ^uint32(0))
+	atomic.AddUint32(&c.done, 1)
+}
+
+// Stage returns the task counters of stage.
+func (s *Stats) Stage(stage Stage) StageStats {
+	c := &s.stages[stage]
+	st := StageStats{
+		Queued:   atomic.LoadUint32(&c.queued),
+		InFlight: atomic.LoadUint32(&c.inFlight),
+		Done:     atomic.LoadUint32(&c.done),
+	}
+	if startedAt := atomic.LoadInt64(&c.startedAt); startedAt != 0 {
+		st.StartedAt = time.Unix(0, startedAt)
+	}
+	return st
+}
+
+// ProgressLine describes the progress of every stage that has tasks, for
+// example "requests 12/40 3.1/s ETA 9s".
+func (s *Stats) ProgressLine(now time.Time) string {
+	var parts []string
+	for _, stage := range Stages() {
+		st := s.Stage(stage)
+		if st.Total() == 0 {
+			continue
+		}
+		part := fmt.Sprintf("%s %d/%d %.1f/s", stage, st.Done, st.Total(), st.Rate(now))
+		if eta := st.ETA(now); eta > 0 {
+			part += fmt.Sprintf(" ETA %s", eta.Round(time.Second))
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// StartProgress reports the progress of the scan until StopProgress is
+// called. When standard error is a terminal a status line is kept at the
+// bottom of the output and redrawn every second, otherwise a progress line is
+// logged every interval.
+func (s *Session) StartProgress(interval time.Duration) {
+	if s.progressStop != nil {
+		return
+	}
+
+	terminal := isTerminal(os.Stderr)
+	if terminal {
+		interval = time.Second
+	} else if interval <= 0 {
+		return
+	}
+
+	s.progressStop = make(chan struct{})
+	s.progressDone = make(chan struct{})
+	go func(stop, done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				line := s.Stats.ProgressLine(now)
+				if line == "" {
+					continue
+				}
+				if terminal {
+					s.Out.SetStatus(os.Stderr, line)
+				} else {
+					s.Out.Info("Progress: %s\n", line)
+				}
+			case <-stop:
+				if terminal {
+					s.Out.SetStatus(os.Stderr, "")
+				}
+				return
+			}
+		}
+	}(s.progressStop, s.progressDone)
+}
+
+func (s *Session) StopProgress() {
+	if s.progressStop == nil {
+		return
+	}
+	close(s.progressStop)
+	<-s.progressDone
+	s.progressStop = nil
+	s.progressDone = nil
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RateLimiter spaces out events so that no more than a fixed number happen
+// per second. A nil RateLimiter doesn't limit anything.
+type RateLimiter struct {
+	sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func NewRateLimiter(perSecond float64) *RateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{
+		interval: time.Duration(float64(time.Second) / perSecond),
+	}
+}
+
+// Wait blocks until the caller is allowed to proceed. Slots are handed out in
+// the order Wait is called.
+func (r *RateLimiter) Wait() {
+	if r == nil {
+		return
+	}
+
+	r.Lock()
+	now := time.Now()
+	if r.next.Before(now) {
+		r.next = now
+	}
+	wait := r.next.Sub(now)
+	r.next = r.next.Add(r.interval)
+	r.Unlock()
+
+	time.Sleep(wait)
+}
+
+// HostRateLimiter keeps a separate RateLimiter for every host. A nil
+// HostRateLimiter doesn't limit anything.
+type HostRateLimiter struct {
+	sync.Mutex
+	perSecond float64
+	limiters  map[string]*RateLimiter
+}
+
+func NewHostRateLimiter(perSecond float64) *HostRateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &HostRateLimiter{
+		perSecond: perSecond,
+		limiters:  make(map[string]*RateLimiter),
+	}
+}
+
+func (h *HostRateLimiter) Wait(host string) {
+	if h == nil {
+		return
+	}
+
+	h.Lock()
+	limiter, ok := h.limiters[host]
+	if !ok {
+		limiter = NewRateLimiter(h.perSecond)
+		h.limiters[host] = limiter
+	}
+	h.Unlock()
+
+	limiter.Wait()
+}
+
+// Jitter sleeps for a random duration between zero and max.
+func Jitter(max time.Duration) {
+	if max <= 0 {
+		return
+	}
+	time.Sleep(time.Duration(rand.Int63n(int64(max) + 1)))
+}
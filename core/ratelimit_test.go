@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	if NewRateLimiter(0) != nil || NewRateLimiter(-1) != nil {
+		t.Error("limiter without a rate isn't nil")
+	}
+	var unlimited *RateLimiter
+	unlimited.Wait()
+
+	limiter := NewRateLimiter(20)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.Wait()
+	}
+	// The first call passes at once, the other four wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond || elapsed > time.Second {
+		t.Errorf("5 calls at 20/s took %s, want about 200ms", elapsed)
+	}
+}
+
+func TestRateLimiterIdle(t *testing.T) {
+	limiter := NewRateLimiter(10)
+	limiter.Wait()
+	time.Sleep(150 * time.Millisecond)
+
+	// Unused slots don't pile up into a burst, but a limiter that was idle
+	// for longer than its interval lets the next call through at once.
+	start := time.Now()
+	limiter.Wait()
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("call after idle period waited %s", elapsed)
+	}
+	start = time.Now()
+	limiter.Wait()
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("second call after idle period waited only %s", elapsed)
+	}
+}
+
+func TestHostRateLimiter(t *testing.T) {
+	var unlimited *HostRateLimiter
+	unlimited.Wait("example.com")
+	if NewHostRateLimiter(0) != nil {
+		t.Error("host limiter without a rate isn't nil")
+	}
+
+	limiter := NewHostRateLimiter(10)
+	start := time.Now()
+	for _, host := range []string{"a.example.com", "b.example.com", "c.example.com"} {
+		limiter.Wait(host)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("first calls for different hosts took %s", elapsed)
+	}
+
+	start = time.Now()
+	limiter.Wait("a.example.com")
+	limiter.Wait("a.example.com")
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("two more calls for the same host at 10/s took %s, want about 200ms", elapsed)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	start := time.Now()
+	Jitter(0)
+	Jitter(-time.Second)
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Errorf("jitter without a maximum slept %s", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		Jitter(5 * time.Millisecond)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("10 jitters of at most 5ms took %s", elapsed)
+	}
+}
@@ -1,8 +1,10 @@
 package core
 
 import (
+	"fmt"
 	"html/template"
 	"io"
+	"os"
 )
 
 type Report struct {
@@ -36,3 +38,27 @@ func NewReport(s *Session, templ string) *Report {
 		Template: templ,
 	}
 }
+
+// WriteReport renders the HTML report of the session to aquatone_report.html
+// in the output directory, using the template given with -template-path or
+// the built-in one.
+func (s *Session) WriteReport() error {
+	var templ []byte
+	var err error
+	if *s.Options.TemplatePath != "" {
+		templ, err = os.ReadFile(*s.Options.TemplatePath)
+	} else {
+		templ, err = s.Asset("static/report_template.html")
+	}
+	if err != nil {
+		return fmt.Errorf("Can't read report template file: %s", err)
+	}
+
+	f, err := os.Create(s.GetFilePath("aquatone_report.html"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return NewReport(s, string(templ)).Render(f)
+}
@@ -0,0 +1,371 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type portRange struct {
+	from int
+	to   int
+}
+
+// scopeRule is a single line of a scope file. A rule either matches hosts
+// (optionally restricted to a set of ports) or matches full URLs with a
+// regular expression.
+type scopeRule struct {
+	any      bool
+	network  *net.IPNet
+	domain   string
+	wildcard bool
+	ports    []portRange
+	pattern  *regexp.Regexp
+}
+
+func (r scopeRule) matchesHost(host string) bool {
+	if r.pattern != nil {
+		return false
+	}
+	if r.any {
+		return true
+	}
+	if r.network != nil {
+		ip := net.ParseIP(host)
+		return ip != nil && r.network.Contains(ip)
+	}
+	if r.wildcard {
+		return strings.HasSuffix(host, "."+r.domain)
+	}
+	return host == r.domain
+}
+
+func (r scopeRule) matchesPort(port int) bool {
+	if len(r.ports) == 0 {
+		return true
+	}
+	for _, p := range r.ports {
+		if port >= p.from && port <= p.to {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope decides which targets may be touched during a session. A target is in
+// scope when it matches at least one include rule of each kind that is
+// present (host rules and URL patterns) and no exclude rule. An empty scope
+// allows everything.
+type Scope struct {
+	include []scopeRule
+	exclude []scopeRule
+}
+
+// LoadScope reads scope rules from a file. See ParseScope for the format.
+func LoadScope(filePath string) (*Scope, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseScope(f)
+}
+
+// ParseScope parses one rule per line. Empty lines and lines starting with #
+// are ignored and a leading ! turns a rule into an exclusion. Supported rules:
+//
+//	10.0.0.0/8                       IP address or CIDR range
+//	example.com                      exact hostname
+//	*.example.com                    any subdomain of example.com
+//	example.com:80,8000-8100         hostname, IP or CIDR limited to ports
+//	[2001:db8::/32]:443              IPv6 address or range with ports
+//	*:443                            any host on the given ports
+//	re:^https://app\.example\.com/   regular expression matched against URLs
+func ParseScope(r io.Reader) (*Scope, error) {
+	scope := &Scope{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		exclude := false
+		if strings.HasPrefix(line, "!") {
+			exclude = true
+			line = strings.TrimSpace(line[1:])
+		}
+
+		rule, err := parseScopeRule(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNumber, err)
+		}
+
+		if exclude {
+			scope.exclude = append(scope.exclude, rule)
+		} else {
+			scope.include = append(scope.include, rule)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return scope, nil
+}
+
+func parseScopeRule(line string) (scopeRule, error) {
+	var rule scopeRule
+
+	if strings.HasPrefix(line, "re:") {
+		pattern, err := regexp.Compile(strings.TrimSpace(line[3:]))
+		if err != nil {
+			return rule, fmt.Errorf("invalid URL pattern: %s", err)
+		}
+		rule.pattern = pattern
+		return rule, nil
+	}
+
+	host, ports, err := splitScopeHostPorts(line)
+	if err != nil {
+		return rule, err
+	}
+	if ports != "" {
+		if rule.ports, err = parsePortRanges(ports); err != nil {
+			return rule, err
+		}
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	switch {
+	case host == "*":
+		rule.any = true
+	case strings.Contains(host, "/"):
+		_, network, err := net.ParseCIDR(host)
+		if err != nil {
+			return rule, fmt.Errorf("invalid CIDR range %s", host)
+		}
+		rule.network = network
+	case net.ParseIP(host) != nil:
+		ip := net.ParseIP(host)
+		bits := 128
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 32
+		}
+		rule.network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	case strings.HasPrefix(host, "*."):
+		rule.domain = host[2:]
+		rule.wildcard = true
+	case host == "" || strings.ContainsAny(host, "*/ "):
+		return rule, fmt.Errorf("invalid host %s", host)
+	default:
+		rule.domain = host
+	}
+
+	return rule, nil
+}
+
+// splitScopeHostPorts splits "host:ports" while leaving bare IPv6 addresses
+// and ranges intact. IPv6 rules with ports must use brackets.
+func splitScopeHostPorts(s string) (string, string, error) {
+	if strings.HasPrefix(s, "[") {
+		end := strings.Index(s, "]")
+		if end == -1 {
+			return "", "", fmt.Errorf("missing closing bracket in %s", s)
+		}
+		rest := s[end+1:]
+		if rest == "" {
+			return s[1:end], "", nil
+		}
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", fmt.Errorf("invalid rule %s", s)
+		}
+		return s[1:end], rest[1:], nil
+	}
+
+	if strings.Count(s, ":") == 1 {
+		i := strings.Index(s, ":")
+		return s[:i], s[i+1:], nil
+	}
+
+	return s, "", nil
+}
+
+func parsePortRanges(s string) ([]portRange, error) {
+	var ranges []portRange
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		bounds := strings.SplitN(part, "-", 2)
+		from, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %s", part)
+		}
+		to := from
+		if len(bounds) == 2 {
+			if to, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+				return nil, fmt.Errorf("invalid port range %s", part)
+			}
+		}
+		if from < 1 || to > 65535 || from > to {
+			return nil, fmt.Errorf("invalid port range %s", part)
+		}
+		ranges = append(ranges, portRange{from: from, to: to})
+	}
+	return ranges, nil
+}
+
+func normalizeScopeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+}
+
+func (s *Scope) hasHostIncludes() bool {
+	for _, rule := range s.include {
+		if rule.pattern == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Scope) hasPatternIncludes() bool {
+	for _, rule := range s.include {
+		if rule.pattern != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsHost reports whether a host may be touched at all. Port restricted
+// exclusions don't exclude the whole host.
+func (s *Scope) AllowsHost(host string) bool {
+	if s == nil {
+		return true
+	}
+	host = normalizeScopeHost(host)
+
+	for _, rule := range s.exclude {
+		if len(rule.ports) == 0 && rule.matchesHost(host) {
+			return false
+		}
+	}
+
+	if !s.hasHostIncludes() {
+		return true
+	}
+	for _, rule := range s.include {
+		if rule.matchesHost(host) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsPort reports whether a port on a host may be connected to.
+func (s *Scope) AllowsPort(host string, port int) bool {
+	if s == nil {
+		return true
+	}
+	host = normalizeScopeHost(host)
+
+	for _, rule := range s.exclude {
+		if rule.matchesHost(host) && rule.matchesPort(port) {
+			return false
+		}
+	}
+
+	if !s.hasHostIncludes() {
+		return true
+	}
+	for _, rule := range s.include {
+		if rule.matchesHost(host) && rule.matchesPort(port) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsURL reports whether a URL may be requested. Its host and port must be
+// allowed and, if any URL patterns are included, at least one must match.
+func (s *Scope) AllowsURL(rawURL string) bool {
+	if s == nil {
+		return true
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	if !s.AllowsPort(u.Hostname(), scopeURLPort(u)) {
+		return false
+	}
+
+	for _, rule := range s.exclude {
+		if rule.pattern != nil && rule.pattern.MatchString(rawURL) {
+			return false
+		}
+	}
+
+	if !s.hasPatternIncludes() {
+		return true
+	}
+	for _, rule := range s.include {
+		if rule.pattern != nil && rule.pattern.MatchString(rawURL) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludesURL reports whether a URL matches an exclude rule, by its host and
+// port or by a URL pattern.
+func (s *Scope) ExcludesURL(rawURL string) bool {
+	if s == nil {
+		return false
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return true
+	}
+
+	host := normalizeScopeHost(u.Hostname())
+	port := scopeURLPort(u)
+	for _, rule := range s.exclude {
+		if rule.pattern != nil {
+			if rule.pattern.MatchString(rawURL) {
+				return true
+			}
+			continue
+		}
+		if rule.matchesHost(host) && rule.matchesPort(port) {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeURLPort returns the port of u, or the default port of its scheme.
+func scopeURLPort(u *url.URL) int {
+	port, _ := strconv.Atoi(u.Port())
+	if port == 0 {
+		switch strings.ToLower(u.Scheme) {
+		case "https":
+			port = 443
+		default:
+			port = 80
+		}
+	}
+	return port
+}
@@ -0,0 +1,166 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+const testScope = `
+# comment
+10.0.0.0/24
+192.168.1.5
+*.example.com
+example.org:80,8000-8100
+[2001:db8::/32]:443
+!10.0.0.13
+!admin.example.com
+!*.example.com:8443
+!re:/logout
+`
+
+func TestParseScopeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope string
+	}{
+		{"invalid cidr", "10.0.0.0/33"},
+		{"invalid host", "foo*bar.com"},
+		{"invalid port", "example.com:http"},
+		{"port out of range", "example.com:0-70000"},
+		{"reversed port range", "example.com:90-80"},
+		{"invalid pattern", "re:("},
+		{"unclosed bracket", "[2001:db8::1:443"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseScope(strings.NewReader(tt.scope)); err == nil {
+				t.Errorf("ParseScope(%q) returned no error", tt.scope)
+			}
+		})
+	}
+}
+
+func TestScopeAllowsHost(t *testing.T) {
+	scope, err := ParseScope(strings.NewReader(testScope))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"10.0.0.1", true},
+		{"10.0.1.1", false},
+		{"10.0.0.13", false},
+		{"192.168.1.5", true},
+		{"192.168.1.6", false},
+		{"www.example.com", true},
+		{"WWW.Example.COM.", true},
+		{"example.com", false},
+		{"admin.example.com", false},
+		{"example.org", true},
+		{"2001:db8::1", true},
+		{"[2001:db8::1]", true},
+		{"2001:db9::1", false},
+		{"example.net", false},
+	}
+	for _, tt := range tests {
+		if got := scope.AllowsHost(tt.host); got != tt.want {
+			t.Errorf("AllowsHost(%q) = %v, want %v", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestScopeAllowsPort(t *testing.T) {
+	scope, err := ParseScope(strings.NewReader(testScope))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		host string
+		port int
+		want bool
+	}{
+		{"example.org", 80, true},
+		{"example.org", 8050, true},
+		{"example.org", 443, false},
+		{"www.example.com", 443, true},
+		{"www.example.com", 8443, false},
+		{"2001:db8::1", 443, true},
+		{"2001:db8::1", 80, false},
+		{"10.0.0.13", 80, false},
+	}
+	for _, tt := range tests {
+		if got := scope.AllowsPort(tt.host, tt.port); got != tt.want {
+			t.Errorf("AllowsPort(%q, %d) = %v, want %v", tt.host, tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestScopeAllowsURL(t *testing.T) {
+	scope, err := ParseScope(strings.NewReader(testScope + "re:^https?://[^/]+/app/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://www.example.com/app/", true},
+		{"https://www.example.com/", false},
+		{"https://www.example.com/app/logout", false},
+		{"https://www.example.com:8443/app/", false},
+		{"http://example.org/app/", true},
+		{"https://example.org/app/", false},
+		{"http://10.0.0.1:8080/app/", true},
+		{"http://10.0.0.13/app/", false},
+		{"http://example.net/app/", false},
+		{"://", false},
+	}
+	for _, tt := range tests {
+		if got := scope.AllowsURL(tt.url); got != tt.want {
+			t.Errorf("AllowsURL(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestScopeExcludesURL(t *testing.T) {
+	scope, err := ParseScope(strings.NewReader(testScope))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"http://admin.example.com/", true},
+		{"https://www.example.com:8443/", true},
+		{"https://www.example.com/", false},
+		{"https://www.example.com/logout", true},
+		{"http://10.0.0.13/", true},
+		{"http://example.net/", false},
+	}
+	for _, tt := range tests {
+		if got := scope.ExcludesURL(tt.url); got != tt.want {
+			t.Errorf("ExcludesURL(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestEmptyScope(t *testing.T) {
+	var scope *Scope
+	if !scope.AllowsHost("example.com") || !scope.AllowsPort("example.com", 1) || !scope.AllowsURL("http://example.com/") {
+		t.Error("nil scope doesn't allow everything")
+	}
+	if scope.ExcludesURL("http://example.com/") {
+		t.Error("nil scope excludes a URL")
+	}
+
+	scope, err := ParseScope(strings.NewReader("!example.com\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !scope.AllowsHost("example.net") || scope.AllowsHost("example.com") {
+		t.Error("scope with only exclusions doesn't allow every other host")
+	}
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Service is a non-web service found on an open port, such as SSH or a
+// database. Ports with services are not requested as URLs.
+type Service struct {
+	Host   string `json:"host"`
+	Port   int    `json:"port"`
+	Name   string `json:"name"`
+	TLS    bool   `json:"tls"`
+	Banner string `json:"banner,omitempty"`
+}
+
+// Addr returns the host:port pair of the service.
+func (svc *Service) Addr() string {
+	return net.JoinHostPort(svc.Host, strconv.Itoa(svc.Port))
+}
+
+// AddService records a service found on an open port, replacing a service
+// found on the same port before.
+func (s *Session) AddService(svc *Service) {
+	s.Lock()
+	defer s.Unlock()
+	if s.Services == nil {
+		s.Services = make(map[string]*Service)
+	}
+	s.Services[strings.ToLower(svc.Addr())] = svc
+}
+
+// HasService reports whether a service was found on port of host.
+func (s *Session) HasService(host string, port int) bool {
+	addr := strings.ToLower(net.JoinHostPort(host, strconv.Itoa(port)))
+	s.Lock()
+	defer s.Unlock()
+	_, ok := s.Services[addr]
+	return ok
+}
+
+// ServiceList returns the services of the session ordered by host and port.
+func (s *Session) ServiceList() []*Service {
+	s.Lock()
+	services := make([]*Service, 0, len(s.Services))
+	for _, svc := range s.Services {
+		services = append(services, svc)
+	}
+	s.Unlock()
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].Host != services[j].Host {
+			return services[i].Host < services[j].Host
+		}
+		return services[i].Port < services[j].Port
+	})
+	return services
+}
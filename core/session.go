@@ -3,10 +3,12 @@ package core
 import (
 	"archive/tar"
 	"compress/gzip"
+	"context"
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
@@ -27,6 +29,8 @@ type Stats struct {
 	FinishedAt           time.Time `json:"finishedAt"`
 	PortOpen             uint32    `json:"portOpen"`
 	PortClosed           uint32    `json:"portClosed"`
+	PortFiltered         uint32    `json:"portFiltered"`
+	PortUnreachable      uint32    `json:"portUnreachable"`
 	RequestSuccessful    uint32    `json:"requestSuccessful"`
 	RequestFailed        uint32    `json:"requestFailed"`
 	ResponseCode2xx      uint32    `json:"responseCode2xx"`
@@ -35,12 +39,45 @@ type Stats struct {
 	ResponseCode5xx      uint32    `json:"responseCode5xx"`
 	ScreenshotSuccessful uint32    `json:"screenshotSuccessful"`
 	ScreenshotFailed     uint32    `json:"screenshotFailed"`
+	OutOfScope           uint32    `json:"outOfScope"`
+	stages               [stageCount]stageCounters
 }
 
 func (s *Stats) Duration() time.Duration {
 	return s.FinishedAt.Sub(s.StartedAt)
 }
 
+// Snapshot returns a copy of the counters that is safe to take while a scan
+// is running.
+func (s *Stats) Snapshot() Stats {
+	return Stats{
+		StartedAt:            s.StartedAt,
+		PortOpen:             atomic.LoadUint32(&s.PortOpen),
+		PortClosed:           atomic.LoadUint32(&s.PortClosed),
+		PortFiltered:         atomic.LoadUint32(&s.PortFiltered),
+		PortUnreachable:      atomic.LoadUint32(&s.PortUnreachable),
+		RequestSuccessful:    atomic.LoadUint32(&s.RequestSuccessful),
+		RequestFailed:        atomic.LoadUint32(&s.RequestFailed),
+		ResponseCode2xx:      atomic.LoadUint32(&s.ResponseCode2xx),
+		ResponseCode3xx:      atomic.LoadUint32(&s.ResponseCode3xx),
+		ResponseCode4xx:      atomic.LoadUint32(&s.ResponseCode4xx),
+		ResponseCode5xx:      atomic.LoadUint32(&s.ResponseCode5xx),
+		ScreenshotSuccessful: atomic.LoadUint32(&s.ScreenshotSuccessful),
+		ScreenshotFailed:     atomic.LoadUint32(&s.ScreenshotFailed),
+		OutOfScope:           atomic.LoadUint32(&s.OutOfScope),
+	}
+}
+
+// MarshalJSON encodes a snapshot of the counters, so that the session can be
+// checkpointed while agents are updating them. FinishedAt is guarded by the
+// session lock.
+func (s *Stats) MarshalJSON() ([]byte, error) {
+	type stats Stats
+	snapshot := s.Snapshot()
+	snapshot.FinishedAt = s.FinishedAt
+	return json.Marshal((*stats)(&snapshot))
+}
+
 func (s *Stats) IncrementPortOpen() {
 	atomic.AddUint32(&s.PortOpen, 1)
 }
@@ -49,6 +86,14 @@ func (s *Stats) IncrementPortClosed() {
 	atomic.AddUint32(&s.PortClosed, 1)
 }
 
+func (s *Stats) IncrementPortFiltered() {
+	atomic.AddUint32(&s.PortFiltered, 1)
+}
+
+func (s *Stats) IncrementPortUnreachable() {
+	atomic.AddUint32(&s.PortUnreachable, 1)
+}
+
 func (s *Stats) IncrementRequestSuccessful() {
 	atomic.AddUint32(&s.RequestSuccessful, 1)
 }
@@ -81,6 +126,42 @@ func (s *Stats) IncrementScreenshotFailed() {
 	atomic.AddUint32(&s.ScreenshotFailed, 1)
 }
 
+func (s *Stats) IncrementOutOfScope() {
+	atomic.AddUint32(&s.OutOfScope, 1)
+}
+
+// addResumedPage counts a page loaded from a resumed session the same way
+// as a page requested and screenshotted in this run.
+func (s *Stats) addResumedPage(page *Page) {
+	s.IncrementRequestSuccessful()
+	s.IncrementScreenshotSuccessful()
+	code, _ := strconv.Atoi(strings.SplitN(page.Status, " ", 2)[0])
+	switch {
+	case code >= 500:
+		s.IncrementResponseCode5xx()
+	case code >= 400:
+		s.IncrementResponseCode4xx()
+	case code >= 300:
+		s.IncrementResponseCode3xx()
+	default:
+		s.IncrementResponseCode2xx()
+	}
+}
+
+// addResumedPortState counts a port state loaded from a resumed session.
+func (s *Stats) addResumedPortState(state PortState) {
+	switch state {
+	case PortOpen:
+		s.IncrementPortOpen()
+	case PortClosed:
+		s.IncrementPortClosed()
+	case PortFiltered:
+		s.IncrementPortFiltered()
+	case PortUnreachable:
+		s.IncrementPortUnreachable()
+	}
+}
+
 type Session struct {
 	sync.Mutex
 	Version                string                        `json:"version"`
@@ -89,30 +170,55 @@ type Session struct {
 	Stats                  *Stats                        `json:"stats"`
 	Pages                  map[string]*Page              `json:"pages"`
 	PageSimilarityClusters map[string][]string           `json:"pageSimilarityClusters"`
+	Diff                   *SessionDiff                  `json:"diff,omitempty"`
+	PortStates             map[string]map[int]PortState  `json:"portStates,omitempty"`
+	Services               map[string]*Service           `json:"services,omitempty"`
 	Ports                  []int                         `json:"-"`
 	EventBus               EventBus.Bus                  `json:"-"`
 	WaitGroup              sizedwaitgroup.SizedWaitGroup `json:"-"`
 	OutFile                *os.File                      `json:"-"`
-}
-
-func (s *Session) Start() {
+	Scope                  *Scope                        `json:"-"`
+	Headers                http.Header                   `json:"-"`
+	Credentials            []*Credential                 `json:"-"`
+	Cookies                *CookieJar                    `json:"-"`
+	rateLimiter            *RateLimiter
+	hostRateLimiter        *HostRateLimiter
+	targetNotes            map[string][]Note
+	comparison             *comparison
+	checkpointStop         chan struct{}
+	progressStop           chan struct{}
+	progressDone           chan struct{}
+	ctx                    context.Context
+	pageHandlers           int
+	virtualHosts           map[string]string
+}
+
+func (s *Session) Start() error {
 	s.Pages = make(map[string]*Page)
 	s.PageSimilarityClusters = make(map[string][]string)
+	s.ctx = context.Background()
 	s.initStats()
 	s.initLogger()
-	s.initPorts()
+	if err := s.initPorts(); err != nil {
+		return err
+	}
 	s.initThreads()
 	s.initEventBus()
 	s.initWaitGroup()
-	s.initDirectories()
+	s.initRateLimiters()
+	return s.initDirectories()
 }
 
 func (s *Session) End() {
+	s.Lock()
+	defer s.Unlock()
 	s.Stats.FinishedAt = time.Now()
 }
 
 func (s *Session) Close() {
-	_ = s.OutFile.Close()
+	if s.OutFile != nil {
+		_ = s.OutFile.Close()
+	}
 }
 
 func (s *Session) AddPage(url string) (*Page, error) {
@@ -126,19 +232,153 @@ func (s *Session) AddPage(url string) (*Page, error) {
 	if err != nil {
 		return nil, err
 	}
+	page.Notes = append(page.Notes, s.targetNotes[page.hostPort()]...)
 
 	s.Pages[url] = page
 	return page, nil
 }
 
+// AddTargetNote records a note about a host:port target, such as a service
+// version reported by an input file. It is attached to every page that is
+// later created for that host and port.
+func (s *Session) AddTargetNote(hostPort string, text string, noteType string) {
+	s.Lock()
+	defer s.Unlock()
+	if s.targetNotes == nil {
+		s.targetNotes = make(map[string][]Note)
+	}
+	s.targetNotes[strings.ToLower(hostPort)] = append(s.targetNotes[strings.ToLower(hostPort)], Note{
+		Text: text,
+		Type: noteType,
+	})
+}
+
+// SubscribePageHandler subscribes an agent to core.URLResponsive events. Each
+// handler must call PageTaskDone exactly once per page when it is finished
+// with it, so that the session knows when a page is fully processed.
+func (s *Session) SubscribePageHandler(fn func(url string)) error {
+	if err := s.EventBus.SubscribeAsync(URLResponsive, fn, false); err != nil {
+		return err
+	}
+	s.pageHandlers++
+	return nil
+}
+
+// PublishURLResponsive hands a page to all page handlers. core.URLProcessed is
+// published once every handler has called PageTaskDone for it.
+func (s *Session) PublishURLResponsive(page *Page) {
+	if s.pageHandlers == 0 {
+		s.EventBus.Publish(URLProcessed, page.URL)
+		return
+	}
+	page.AddTasks(s.pageHandlers)
+	s.EventBus.Publish(URLResponsive, page.URL)
+}
+
+func (s *Session) PageTaskDone(page *Page) {
+	if page.doneTask() {
+		s.EventBus.Publish(URLProcessed, page.URL)
+	}
+}
+
+// InScopeHost reports whether host may be touched. Out of scope hosts are
+// logged and counted.
+func (s *Session) InScopeHost(host string) bool {
+	if s.Scope.AllowsHost(host) {
+		return true
+	}
+	s.Stats.IncrementOutOfScope()
+	s.Out.Info("%s: %s\n", host, s.Out.Yellow("out of scope"))
+	return false
+}
+
+// InScopePort reports whether port on host may be connected to. Out of scope
+// ports are counted but only logged in debug mode, as port lists are long.
+func (s *Session) InScopePort(host string, port int) bool {
+	if s.Scope.AllowsPort(host, port) {
+		return true
+	}
+	s.Stats.IncrementOutOfScope()
+	s.Out.Debug("%s: port %d is out of scope\n", host, port)
+	return false
+}
+
+// InScopeURL reports whether url may be requested. Out of scope URLs are
+// logged and counted.
+func (s *Session) InScopeURL(url string) bool {
+	if s.AllowsURL(url) {
+		return true
+	}
+	s.outOfScope(url)
+	return false
+}
+
+func (s *Session) outOfScope(url string) {
+	s.Stats.IncrementOutOfScope()
+	s.Out.Info("%s: %s\n", url, s.Out.Yellow("out of scope"))
+}
+
+// Throttle blocks until a request to host is allowed by the global and per
+// host rate limits, plus a random jitter if configured. Agents call it before
+// every connection they make to a target.
+func (s *Session) Throttle(host string) {
+	Jitter(time.Duration(*s.Options.Jitter) * time.Millisecond)
+	s.hostRateLimiter.Wait(strings.ToLower(host))
+	s.rateLimiter.Wait()
+}
+
+// Drain blocks until every published event is handled and every agent task
+// is finished, including the work queued by events published meanwhile.
+func (s *Session) Drain() {
+	bus, counting := s.EventBus.(*countingBus)
+	for {
+		var published uint64
+		if counting {
+			published = bus.Published()
+		}
+		s.EventBus.WaitAsync()
+		s.WaitGroup.Wait()
+		if !counting || bus.Published() == published {
+			return
+		}
+	}
+}
+
+// SetContext sets the context of the scan. Agents stop taking on new work
+// once it is cancelled.
+func (s *Session) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *Session) Context() context.Context {
+	return s.ctx
+}
+
+// Stopped reports whether the context of the scan is cancelled. Agents check
+// it before connecting to targets.
+func (s *Session) Stopped() bool {
+	return s.ctx.Err() != nil
+}
+
 func (s *Session) GetPage(url string) *Page {
+	s.Lock()
+	defer s.Unlock()
 	if page, ok := s.Pages[url]; ok {
 		return page
 	}
 	return nil
 }
 
+func (s *Session) HasPage(url string) bool {
+	s.Lock()
+	defer s.Unlock()
+	_, ok := s.Pages[url]
+	return ok
+}
+
 func (s *Session) GetPageByUUID(id string) *Page {
+	s.Lock()
+	defer s.Unlock()
 	for _, page := range s.Pages {
 		if page.UUID == id {
 			return page
@@ -174,32 +414,13 @@ func (s *Session) initStats() {
 	}
 }
 
-func (s *Session) initPorts() {
-	var ports []int
-	switch *s.Options.Ports {
-	case "small":
-		ports = SmallPortList
-	case "", "medium", "default":
-		ports = MediumPortList
-	case "large":
-		ports = LargePortList
-	case "xlarge", "huge":
-		ports = XLargePortList
-	default:
-		for _, p := range strings.Split(*s.Options.Ports, ",") {
-			port, err := strconv.Atoi(strings.TrimSpace(p))
-			if err != nil {
-				s.Out.Fatal("Invalid port range given\n")
-				os.Exit(1)
-			}
-			if port < 1 || port > 65535 {
-				s.Out.Fatal("Invalid port given: %v\n", port)
-				os.Exit(1)
-			}
-			ports = append(ports, port)
-		}
+func (s *Session) initPorts() error {
+	ports, err := ParsePorts(*s.Options.Ports)
+	if err != nil {
+		return err
 	}
 	s.Ports = ports
+	return nil
 }
 
 func (s *Session) initLogger() {
@@ -212,6 +433,7 @@ func (s *Session) initLogger() {
 		if err == nil {
 			writer = file
 			noColor = true
+			s.OutFile = file
 		}
 	}
 
@@ -226,24 +448,29 @@ func (s *Session) initThreads() {
 }
 
 func (s *Session) initEventBus() {
-	s.EventBus = EventBus.New()
+	s.EventBus = &countingBus{Bus: EventBus.New()}
 }
 
 func (s *Session) initWaitGroup() {
 	s.WaitGroup = sizedwaitgroup.New(*s.Options.Threads)
 }
 
-func (s *Session) initDirectories() {
+func (s *Session) initRateLimiters() {
+	s.rateLimiter = NewRateLimiter(*s.Options.RateLimit)
+	s.hostRateLimiter = NewHostRateLimiter(*s.Options.HostRateLimit)
+}
+
+func (s *Session) initDirectories() error {
 	for _, d := range []string{"headers", "html", "screenshots"} {
 		d = s.GetFilePath(d)
 		if _, err := os.Stat(d); os.IsNotExist(err) {
 			err = os.MkdirAll(d, 0755)
 			if err != nil {
-				s.Out.Fatal("Failed to create required directory %s\n", d)
-				os.Exit(1)
+				return fmt.Errorf("Failed to create required directory %s", d)
 			}
 		}
 	}
+	return nil
 }
 
 func (s *Session) BaseFilenameFromURL(str string) string {
@@ -276,6 +503,8 @@ func (s *Session) ReadFile(p string) ([]byte, error) {
 }
 
 func (s *Session) ToJSON() string {
+	s.Lock()
+	defer s.Unlock()
 	sessionJSON, _ := json.Marshal(s)
 	return string(sessionJSON)
 }
@@ -283,27 +512,117 @@ func (s *Session) ToJSON() string {
 func (s *Session) SaveToFile(filename string) error {
 	filePath := s.GetFilePath(filename)
 
-	err := os.WriteFile(filePath, []byte(s.ToJSON()), 0644)
+	// Write to a temporary file first so that a crash during a checkpoint
+	// never leaves a truncated session file behind.
+	err := os.WriteFile(filePath+".tmp", []byte(s.ToJSON()), 0644)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return os.Rename(filePath+".tmp", filePath)
+}
+
+// Resume loads pages, port states and services from a previously saved
+// session file. Pages that were not fully processed (no response status or no
+// screenshot) are dropped so that they are requested again. Ports with a
+// saved state are not scanned again, and open ports are only requested if
+// they have no kept page or service. The counters are rebuilt from what was
+// kept, since everything else is done again. It returns the number of pages
+// that were kept.
+func (s *Session) Resume(filePath string) (int, error) {
+	jsonSession, err := os.ReadFile(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	var parsedSession Session
+	if err := json.Unmarshal(jsonSession, &parsedSession); err != nil {
+		return 0, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	for url, page := range parsedSession.Pages {
+		if page.Status == "" || !page.HasScreenshot {
+			continue
+		}
+		s.Pages[url] = page
+		s.Stats.addResumedPage(page)
+	}
+
+	for host, states := range parsedSession.PortStates {
+		if s.PortStates == nil {
+			s.PortStates = make(map[string]map[int]PortState)
+		}
+		s.PortStates[host] = states
+		for _, state := range states {
+			s.Stats.addResumedPortState(state)
+		}
+	}
+	for addr, svc := range parsedSession.Services {
+		if s.Services == nil {
+			s.Services = make(map[string]*Service)
+		}
+		s.Services[addr] = svc
+	}
+
+	return len(s.Pages), nil
+}
+
+func (s *Session) StartCheckpoints(filename string, interval time.Duration) {
+	if interval <= 0 || s.checkpointStop != nil {
+		return
+	}
+
+	s.checkpointStop = make(chan struct{})
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.SaveToFile(filename); err != nil {
+					s.Out.Error("Failed to write session checkpoint: %s\n", err)
+					continue
+				}
+				s.Out.Debug("Wrote session checkpoint to %s\n", s.GetFilePath(filename))
+			case <-stop:
+				return
+			}
+		}
+	}(s.checkpointStop)
+}
+
+func (s *Session) StopCheckpoints() {
+	if s.checkpointStop == nil {
+		return
+	}
+	close(s.checkpointStop)
+	s.checkpointStop = nil
 }
 
 func (s *Session) Asset(name string) ([]byte, error) {
 	return Asset(name)
 }
 
+// NewSession creates a session from command line flags.
 func NewSession() (*Session, error) {
+	options, err := ParseOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSessionWithOptions(options)
+}
+
+// NewSessionWithOptions validates options and creates a session from them.
+// Options are usually created with DefaultOptions and then changed as needed.
+func NewSessionWithOptions(options Options) (*Session, error) {
 	var err error
 	var session Session
 
 	session.Version = Version
-
-	if session.Options, err = ParseOptions(); err != nil {
-		return nil, err
-	}
+	session.Options = options
 
 	if err = session.initPaths(); err != nil {
 		return nil, err
@@ -321,12 +640,119 @@ func NewSession() (*Session, error) {
 		}
 	}
 
+	if *session.Options.Compare != "" {
+		if _, err := os.Stat(*session.Options.Compare); os.IsNotExist(err) {
+			return nil, fmt.Errorf("Compare session path %s does not exist", *session.Options.Compare)
+		}
+	}
+
 	if *session.Options.TemplatePath != "" {
 		if _, err := os.Stat(*session.Options.TemplatePath); os.IsNotExist(err) {
 			return nil, fmt.Errorf("Template path %s does not exist", *session.Options.TemplatePath)
 		}
 	}
 
+	switch *session.Options.ScreenshotWait {
+	case "load", "domcontentloaded", "networkidle":
+	default:
+		return nil, fmt.Errorf("Invalid screenshot wait condition %s", *session.Options.ScreenshotWait)
+	}
+
+	switch *session.Options.ScreenshotFormat {
+	case "png", "jpeg", "webp":
+	default:
+		return nil, fmt.Errorf("Invalid screenshot format %s", *session.Options.ScreenshotFormat)
+	}
+
+	if *session.Options.ScreenshotQuality < 1 || *session.Options.ScreenshotQuality > 100 {
+		return nil, fmt.Errorf("Screenshot quality must be between 1 and 100")
+	}
+
+	if *session.Options.Scope != "" {
+		if session.Scope, err = LoadScope(*session.Options.Scope); err != nil {
+			return nil, fmt.Errorf("Unable to load scope file %s: %s", *session.Options.Scope, err)
+		}
+	}
+
+	session.Headers = make(http.Header)
+	for _, header := range *session.Options.Headers {
+		name, value, err := ParseHeader(header)
+		if err != nil {
+			return nil, err
+		}
+		session.Headers.Add(name, value)
+	}
+
+	for _, auth := range *session.Options.Auth {
+		credential, err := ParseCredential(auth)
+		if err != nil {
+			return nil, err
+		}
+		session.Credentials = append(session.Credentials, credential)
+	}
+
+	if *session.Options.Cookies != "" {
+		if session.Cookies, err = LoadCookieJar(*session.Options.Cookies); err != nil {
+			return nil, fmt.Errorf("Unable to load cookie jar %s: %s", *session.Options.Cookies, err)
+		}
+	}
+
+	switch *session.Options.ClusterMode {
+	case "structure", "visual", "combined":
+	default:
+		return nil, fmt.Errorf("Invalid cluster mode %s", *session.Options.ClusterMode)
+	}
+
+	switch *session.Options.VisualHash {
+	case "ahash", "dhash", "phash":
+	default:
+		return nil, fmt.Errorf("Invalid visual hash %s", *session.Options.VisualHash)
+	}
+
+	if *session.Options.StructureWeight < 0 || *session.Options.VisualWeight < 0 || *session.Options.StructureWeight+*session.Options.VisualWeight == 0 {
+		return nil, fmt.Errorf("Cluster weights must not be negative and not both zero")
+	}
+
+	if *session.Options.SimilarityThreshold < 0 || *session.Options.SimilarityThreshold > 1 {
+		return nil, fmt.Errorf("Similarity threshold must be between 0 and 1")
+	}
+
+	if *session.Options.Soft404Threshold < 0 || *session.Options.Soft404Threshold > 1 {
+		return nil, fmt.Errorf("Soft 404 threshold must be between 0 and 1")
+	}
+
+	if *session.Options.VHostThreshold < 0 || *session.Options.VHostThreshold > 1 {
+		return nil, fmt.Errorf("Virtual host threshold must be between 0 and 1")
+	}
+
+	if *session.Options.VHosts != "" {
+		if _, err := os.Stat(*session.Options.VHosts); os.IsNotExist(err) {
+			return nil, fmt.Errorf("Virtual host list %s does not exist", *session.Options.VHosts)
+		}
+	}
+
+	if *session.Options.Paths != "" {
+		if _, err := os.Stat(*session.Options.Paths); os.IsNotExist(err) {
+			return nil, fmt.Errorf("Path wordlist %s does not exist", *session.Options.Paths)
+		}
+	}
+
+	if *session.Options.RateLimit < 0 || *session.Options.HostRateLimit < 0 || *session.Options.Jitter < 0 {
+		return nil, fmt.Errorf("Rate limits and jitter must not be negative")
+	}
+
+	if *session.Options.ScanTimeout <= 0 {
+		return nil, fmt.Errorf("Port scan timeout must be positive")
+	}
+
+	if *session.Options.BannerTimeout < 0 {
+		return nil, fmt.Errorf("Banner timeout must not be negative")
+	}
+
+	if *session.Options.HostConnections < 0 {
+		return nil, fmt.Errorf("Connections per host must not be negative")
+	}
+
 	envOutPath := os.Getenv("AQUATONE_OUT_PATH")
 	if *session.Options.OutDir == "." && envOutPath != "" {
 		session.Options.OutDir = &envOutPath
@@ -336,7 +762,9 @@ func NewSession() (*Session, error) {
 	session.Options.OutDir = &outdir
 
 	session.Version = Version
-	session.Start()
+	if err = session.Start(); err != nil {
+		return nil, err
+	}
 
 	return &session, nil
 }
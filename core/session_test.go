@@ -0,0 +1,92 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const savedSession = `{
+	"version": "1.7.0",
+	"stats": {"portOpen": 4, "portClosed": 1, "requestSuccessful": 3, "responseCode2xx": 3, "screenshotSuccessful": 1, "screenshotFailed": 1},
+	"pages": {
+		"http://done.example.com/": {"url": "http://done.example.com/", "status": "302 Found", "hasScreenshot": true},
+		"http://noshot.example.com/": {"url": "http://noshot.example.com/", "status": "200 OK"},
+		"http://pending.example.com/": {"url": "http://pending.example.com/"}
+	},
+	"portStates": {
+		"done.example.com": {"80": "open", "8080": "closed"},
+		"noshot.example.com": {"80": "open", "22": "open"},
+		"pending.example.com": {"80": "open"}
+	},
+	"services": {
+		"noshot.example.com:22": {"host": "noshot.example.com", "port": 22, "name": "ssh"}
+	}
+}`
+
+func writeSavedSession(t *testing.T, data string) string {
+	t.Helper()
+	filePath := filepath.Join(t.TempDir(), "aquatone_session.json")
+	if err := os.WriteFile(filePath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filePath
+}
+
+func TestResume(t *testing.T) {
+	s := &Session{Pages: make(map[string]*Page), Stats: &Stats{}}
+	kept, err := s.Resume(writeSavedSession(t, savedSession))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kept != 1 {
+		t.Errorf("Resume kept %d pages, want 1", kept)
+	}
+	if !s.HasPage("http://done.example.com/") {
+		t.Error("fully processed page was dropped")
+	}
+	for _, url := range []string{"http://noshot.example.com/", "http://pending.example.com/"} {
+		if s.HasPage(url) {
+			t.Errorf("unfinished page %s was kept", url)
+		}
+	}
+
+	// Ports of hosts with unfinished pages keep their state too, only the
+	// pages are requested again.
+	if got := s.PortState("pending.example.com", 80); got != PortOpen {
+		t.Errorf("state of scanned port = %q, want %q", got, PortOpen)
+	}
+	if got := s.PortState("done.example.com", 443); got != "" {
+		t.Errorf("state of port that wasn't scanned = %q", got)
+	}
+	if !s.HasService("noshot.example.com", 22) {
+		t.Error("service was dropped")
+	}
+
+	// The saved counters include the dropped pages, so they are rebuilt
+	// from what was kept.
+	want := Stats{
+		PortOpen:             4,
+		PortClosed:           1,
+		RequestSuccessful:    1,
+		ResponseCode3xx:      1,
+		ScreenshotSuccessful: 1,
+	}
+	if got := s.Stats.Snapshot(); got != want {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+}
+
+func TestResumeErrors(t *testing.T) {
+	s := &Session{Pages: make(map[string]*Page), Stats: &Stats{}}
+	if _, err := s.Resume(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Resume of a missing file returned no error")
+	}
+	if _, err := s.Resume(writeSavedSession(t, `{"pages": `)); err == nil {
+		t.Error("Resume of a truncated file returned no error")
+	}
+	if len(s.Pages) != 0 {
+		t.Errorf("failed Resume added %d pages", len(s.Pages))
+	}
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+)
+
+var tlsVersionNames = map[uint16]string{
+	tls.VersionSSL30: "SSLv3",
+	tls.VersionTLS10: "TLS 1.0",
+	tls.VersionTLS11: "TLS 1.1",
+	tls.VersionTLS12: "TLS 1.2",
+	tls.VersionTLS13: "TLS 1.3",
+}
+
+type Certificate struct {
+	Subject            string    `json:"subject"`
+	Issuer             string    `json:"issuer"`
+	SANs               []string  `json:"sans"`
+	SerialNumber       string    `json:"serialNumber"`
+	NotBefore          time.Time `json:"notBefore"`
+	NotAfter           time.Time `json:"notAfter"`
+	KeyType            string    `json:"keyType"`
+	KeyBits            int       `json:"keyBits"`
+	SignatureAlgorithm string    `json:"signatureAlgorithm"`
+	FingerprintSHA256  string    `json:"fingerprintSha256"`
+}
+
+type TLSInfo struct {
+	Version     string        `json:"version"`
+	CipherSuite string        `json:"cipherSuite"`
+	Chain       []Certificate `json:"chain"`
+}
+
+func NewCertificate(cert *x509.Certificate) Certificate {
+	c := Certificate{
+		Subject:            cert.Subject.String(),
+		Issuer:             cert.Issuer.String(),
+		SerialNumber:       fmt.Sprintf("%x", cert.SerialNumber),
+		NotBefore:          cert.NotBefore,
+		NotAfter:           cert.NotAfter,
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		FingerprintSHA256:  fmt.Sprintf("%x", sha256.Sum256(cert.Raw)),
+	}
+
+	c.SANs = append(c.SANs, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		c.SANs = append(c.SANs, ip.String())
+	}
+
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		c.KeyType = "RSA"
+		c.KeyBits = key.N.BitLen()
+	case *ecdsa.PublicKey:
+		c.KeyType = "ECDSA"
+		c.KeyBits = key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		c.KeyType = "Ed25519"
+		c.KeyBits = 256
+	default:
+		c.KeyType = "Unknown"
+	}
+
+	return c
+}
+
+// NewTLSInfo describes the negotiated connection parameters and the
+// certificate chain presented by the server, leaf certificate first.
+func NewTLSInfo(state tls.ConnectionState) *TLSInfo {
+	info := &TLSInfo{
+		Version:     tlsVersionNames[state.Version],
+		CipherSuite: tls.CipherSuiteName(state.CipherSuite),
+	}
+	if info.Version == "" {
+		info.Version = fmt.Sprintf("0x%04x", state.Version)
+	}
+
+	for _, cert := range state.PeerCertificates {
+		info.Chain = append(info.Chain, NewCertificate(cert))
+	}
+
+	return info
+}
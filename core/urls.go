@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"strings"
 )
 
 var (
@@ -11,6 +12,9 @@ var (
 
 func HostAndPortToURL(host string, port int, protocol string) string {
 	var url string
+	if strings.Contains(host, ":") {
+		host = fmt.Sprintf("[%s]", host)
+	}
 	if protocol != "" {
 		url = fmt.Sprintf("%s://%s", protocol, host)
 	} else if isSecurePort(port) {
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// AddVirtualHost records that hostname is served by addr, the IP:port of a
+// target, so that connections to hostname on that port go to addr. It
+// returns false if hostname is already known on that port.
+func (s *Session) AddVirtualHost(hostname string, addr string) bool {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	key := strings.ToLower(net.JoinHostPort(hostname, port))
+
+	s.Lock()
+	defer s.Unlock()
+	if s.virtualHosts == nil {
+		s.virtualHosts = make(map[string]string)
+	}
+	if _, ok := s.virtualHosts[key]; ok {
+		return false
+	}
+	s.virtualHosts[key] = addr
+	return true
+}
+
+// VirtualHostAddr returns the address serving addr, a host:port pair, if the
+// host is a virtual host found on a target, or addr otherwise.
+func (s *Session) VirtualHostAddr(addr string) string {
+	s.Lock()
+	defer s.Unlock()
+	if target, ok := s.virtualHosts[strings.ToLower(addr)]; ok {
+		return target
+	}
+	return addr
+}
+
+// VirtualHostOf returns the address serving rawURL if its host is a virtual
+// host found on a target, or an empty string.
+func (s *Session) VirtualHostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	addr := URLAddr(u)
+	if target := s.VirtualHostAddr(addr); target != addr {
+		return target
+	}
+	return ""
+}
+
+// URLAddr returns the host:port pair u connects to.
+func URLAddr(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// AllowsURL reports whether url may be requested without logging it.
+// Virtual hosts are allowed when either their name or the target serving
+// them is in scope, unless their name is excluded.
+func (s *Session) AllowsURL(url string) bool {
+	if s.Scope.AllowsURL(url) {
+		return true
+	}
+	return s.allowsVirtualHost(url, s.VirtualHostOf(url))
+}
+
+// InScopeVirtualHost reports whether url may be requested as a virtual host
+// served by addr before it is recorded with AddVirtualHost. Out of scope URLs
+// are logged and counted.
+func (s *Session) InScopeVirtualHost(url string, addr string) bool {
+	if s.Scope.AllowsURL(url) || s.allowsVirtualHost(url, addr) {
+		return true
+	}
+	s.outOfScope(url)
+	return false
+}
+
+func (s *Session) allowsVirtualHost(url string, addr string) bool {
+	if addr == "" || s.Scope.ExcludesURL(url) {
+		return false
+	}
+	return s.Scope.AllowsURL(virtualHostTargetURL(url, addr))
+}
+
+// virtualHostTargetURL returns rawURL with its host replaced by addr, so that
+// virtual hosts are in scope when the target serving them is.
+func virtualHostTargetURL(rawURL string, addr string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.Host = addr
+	return u.String()
+}
@@ -12,6 +12,7 @@ require (
 	github.com/parnurzeal/gorequest v0.2.15
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/remeh/sizedwaitgroup v1.0.0
+	golang.org/x/image v0.18.0
 	golang.org/x/net v0.0.0-20190424112056-4829fb13d2c6
 )
 
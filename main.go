@@ -1,18 +1,18 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 	"os"
-	"strings"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
 	"time"
 
-	"github.com/google/uuid"
-	"sdg-git.solar.local/golang/aquatone/agents"
 	"sdg-git.solar.local/golang/aquatone/core"
 	"sdg-git.solar.local/golang/aquatone/parsers"
+	"sdg-git.solar.local/golang/aquatone/runner"
 )
 
 var (
@@ -20,29 +20,12 @@ var (
 	err  error
 )
 
-func isURL(s string) bool {
-	u, err := url.ParseRequestURI(s)
-	if err != nil {
-		return false
-	}
-	if u.Scheme == "" {
-		return false
-	}
-	return true
-}
-
-func hasSupportedScheme(s string) bool {
-	u, err := url.ParseRequestURI(s)
-	if err != nil {
-		return false
-	}
-	if u.Scheme == "http" || u.Scheme == "https" {
-		return true
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		serve(os.Args[2:])
+		return
 	}
-	return false
-}
 
-func main() {
 	if sess, err = core.NewSession(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -55,7 +38,7 @@ func main() {
 
 	sess.Out.Important("%s v%s started at %s\n\n", core.Name, core.Version, sess.Stats.StartedAt.Format(time.RFC3339))
 
-	if *sess.Options.SessionPath != "" {
+	if *sess.Options.SessionPath != "" && !*sess.Options.Resume {
 		jsonSession, err := os.ReadFile(*sess.Options.SessionPath)
 		if err != nil {
 			sess.Out.Fatal("Unable to read session file at %s: %s\n", *sess.Options.SessionPath, err)
@@ -99,25 +82,45 @@ func main() {
 		os.Exit(0)
 	}
 
-	registerHandlers()
-
-	reader := bufio.NewReader(os.Stdin)
-	var targets []string
-
-	if *sess.Options.Nmap {
-		parser := parsers.NewNmapParser()
-		targets, err = parser.Parse(reader)
+	if *sess.Options.Resume {
+		sessionPath := *sess.Options.SessionPath
+		if sessionPath == "" {
+			sessionPath = sess.GetFilePath("aquatone_session.json")
+		}
+		resumed, err := sess.Resume(sessionPath)
 		if err != nil {
-			sess.Out.Fatal("Unable to parse input as Nmap/Masscan XML: %s\n", err)
+			sess.Out.Fatal("Unable to resume session from %s: %s\n", sessionPath, err)
 			os.Exit(1)
 		}
-	} else {
-		parser := parsers.NewRegexParser()
-		targets, err = parser.Parse(reader)
-		if err != nil {
-			sess.Out.Fatal("Unable to parse input.\n")
+		sess.Out.Important("Resumed Aquatone session at %s (%d pages already processed)\n\n", sessionPath, resumed)
+	}
+
+	if *sess.Options.Compare != "" {
+		if err := sess.LoadComparison(*sess.Options.Compare); err != nil {
+			sess.Out.Fatal("Unable to load session file to compare with at %s: %s\n", *sess.Options.Compare, err)
 			os.Exit(1)
 		}
+		sess.Out.Important("Comparing with Aquatone session at %s\n\n", *sess.Options.Compare)
+	}
+
+	var scanner *runner.Runner
+	scanner, err = runner.NewWithSession(sess)
+	if err != nil {
+		sess.Out.Fatal("%s\n", err)
+		os.Exit(1)
+	}
+
+	var targets []string
+	var notes map[string][]core.Note
+	targets, notes, err = parsers.ParseInput(os.Stdin, sess.Options)
+	if err != nil {
+		sess.Out.Fatal("%s\n", err)
+		os.Exit(1)
+	}
+	for hostPort, targetNotes := range notes {
+		for _, note := range targetNotes {
+			sess.AddTargetNote(hostPort, note.Text, note.Type)
+		}
 	}
 
 	if len(targets) == 0 {
@@ -127,97 +130,42 @@ func main() {
 
 	sess.Out.Important("Targets    : %d\n", len(targets))
 	sess.Out.Important("Threads    : %d\n", *sess.Options.Threads)
-	sess.Out.Important("Ports      : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	sess.Out.Important("Ports      : %s\n", core.FormatPorts(sess.Ports))
 	sess.Out.Important("Output dir : %s\n\n", *sess.Options.OutDir)
 
-	sess.EventBus.Publish(core.SessionStart)
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupted := handleInterrupts(cancel)
 
-	for _, target := range targets {
-		if isURL(target) {
-			if hasSupportedScheme(target) {
-				sess.EventBus.Publish(core.URL, target)
-			}
-		} else {
-			sess.EventBus.Publish(core.Host, target)
-		}
+	sess.StartCheckpoints("aquatone_session.json", time.Duration(*sess.Options.CheckpointInterval)*time.Second)
+	if *sess.Options.Progress {
+		sess.StartProgress(time.Duration(*sess.Options.ProgressInterval) * time.Second)
 	}
-
-	sess.EventBus.WaitAsync()
-	sess.WaitGroup.Wait()
-
-	sess.EventBus.Publish(core.SessionEnd)
-	sess.EventBus.WaitAsync()
-	sess.WaitGroup.Wait()
-
-	sess.Out.Important("Calculating page structures...")
-	f, _ := os.OpenFile(sess.GetFilePath("aquatone_urls.txt"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	for _, page := range sess.Pages {
-		filename := sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))
-		body, err := os.Open(filename)
-		if err != nil {
-			continue
+	pages, _ := scanner.Run(ctx, targets)
+	sess.StopProgress()
+	sess.StopCheckpoints()
+
+	f, _ := os.OpenFile(sess.GetFilePath("aquatone_urls.txt"), os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	for _, page := range pages {
+		if _, err := os.Stat(sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))); err == nil {
+			f.WriteString(page.URL + "\n")
 		}
-		structure, _ := core.GetPageStructure(body)
-		page.PageStructure = structure
-		f.WriteString(page.URL + "\n")
 	}
 	f.Close()
-	sess.Out.Important(" done\n")
-
-	sess.Out.Important("Clustering similar pages...")
-	for _, page := range sess.Pages {
-		foundCluster := false
-		for clusterUUID, cluster := range sess.PageSimilarityClusters {
-			addToCluster := true
-			for _, pageURL := range cluster {
-				page2 := sess.GetPage(pageURL)
-				if page2 != nil && core.GetSimilarity(page.PageStructure, page2.PageStructure) < 0.80 {
-					addToCluster = false
-					break
-				}
-			}
-
-			if addToCluster {
-				foundCluster = true
-				sess.PageSimilarityClusters[clusterUUID] = append(sess.PageSimilarityClusters[clusterUUID], page.URL)
-				break
-			}
-		}
 
-		if !foundCluster {
-			newClusterUUID := uuid.New().String()
-			sess.PageSimilarityClusters[newClusterUUID] = []string{page.URL}
+	if sess.Diff != nil {
+		diffJSON, _ := json.Marshal(sess.Diff)
+		if err = os.WriteFile(sess.GetFilePath("aquatone_diff.json"), diffJSON, 0644); err != nil {
+			sess.Out.Error("Failed to write diff file: %s\n", err)
 		}
 	}
-	sess.Out.Important(" done\n")
 
 	sess.Out.Important("Generating HTML report...")
-	var template []byte
-	if *sess.Options.TemplatePath != "" {
-		template, err = os.ReadFile(*sess.Options.TemplatePath)
-	} else {
-		template, err = sess.Asset("static/report_template.html")
-	}
-
-	if err != nil {
-		sess.Out.Fatal("Can't read report template file\n")
-		os.Exit(1)
-	}
-	report := core.NewReport(sess, string(template))
-	f, err = os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE, 0644)
-	if err != nil {
-		sess.Out.Fatal("Error during report generation: %s\n", err)
-		os.Exit(1)
-	}
-	err = report.Render(f)
-	if err != nil {
+	if err = sess.WriteReport(); err != nil {
 		sess.Out.Fatal("Error during report generation: %s\n", err)
 		os.Exit(1)
 	}
 	sess.Out.Important(" done\n\n")
 
-	sess.End()
-
 	sess.Out.Important("Writing session file...")
 	err = sess.SaveToFile("aquatone_session.json")
 	if err != nil {
@@ -230,6 +178,19 @@ func main() {
 	sess.Out.Info(" - Finished at : %v\n", sess.Stats.FinishedAt.Format(time.RFC3339))
 	sess.Out.Info(" - Duration    : %v\n\n", sess.Stats.Duration().Round(time.Second))
 
+	if sess.Stats.PortOpen+sess.Stats.PortClosed+sess.Stats.PortFiltered+sess.Stats.PortUnreachable > 0 {
+		sess.Out.Important("Ports:\n")
+		sess.Out.Info(" - Open        : %v\n", sess.Stats.PortOpen)
+		sess.Out.Info(" - Closed      : %v\n", sess.Stats.PortClosed)
+		sess.Out.Info(" - Filtered    : %v\n", sess.Stats.PortFiltered)
+		sess.Out.Info(" - Unreachable : %v\n\n", sess.Stats.PortUnreachable)
+	}
+
+	if len(sess.Services) > 0 {
+		sess.Out.Important("Services:\n")
+		sess.Out.Info(" - Non-web : %v\n\n", len(sess.Services))
+	}
+
 	sess.Out.Important("Requests:\n")
 	sess.Out.Info(" - Successful : %v\n", sess.Stats.RequestSuccessful)
 	sess.Out.Info(" - Failed     : %v\n\n", sess.Stats.RequestFailed)
@@ -243,48 +204,76 @@ func main() {
 	sess.Out.Info(" - Successful : %v\n", sess.Stats.ScreenshotSuccessful)
 	sess.Out.Info(" - Failed     : %v\n\n", sess.Stats.ScreenshotFailed)
 
-	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
-
-	sess.Close()
-
-	if sess.Options.Tar != nil && *sess.Options.Tar {
-		err = sess.Tar()
-		if err != nil {
-			_, _ = fmt.Fprintf(os.Stderr, "tar failed: %v\n", err)
-		}
+	if sess.Diff != nil {
+		sess.Out.Important("Changes since %s:\n", sess.Diff.OldStartedAt.Format(time.RFC3339))
+		sess.Out.Info(" - New URLs      : %v\n", len(sess.Diff.NewURLs))
+		sess.Out.Info(" - Removed URLs  : %v\n", len(sess.Diff.RemovedURLs))
+		sess.Out.Info(" - Changed pages : %v\n\n", len(sess.Diff.ChangedPages))
 	}
-}
 
-func registerHandlers() {
-	if err = agents.NewTCPPortScanner().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register TCPPortScanner: %s\n", err.Error())
+	if sess.Scope != nil {
+		sess.Out.Important("Scope:\n")
+		sess.Out.Info(" - Out of scope : %v\n\n", sess.Stats.OutOfScope)
 	}
 
-	if err = agents.NewURLPublisher().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLPublisher: %s\n", err.Error())
+	if sess.Diff != nil {
+		sess.Out.Important("Wrote diff to: %s\n", sess.GetFilePath("aquatone_diff.json"))
 	}
+	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
 
-	if err = agents.NewURLRequester().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLRequester: %s\n", err.Error())
+	if ctx.Err() != nil {
+		sess.Out.Warn("Scan was interrupted, results are partial. Run again with -resume to finish it.\n\n")
 	}
 
-	if err = agents.NewURLHostnameResolver().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLHostnameResolver: %s\n", err.Error())
-	}
+	sess.Close()
 
-	if err = agents.NewURLPageTitleExtractor().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLPageTitleExtractor: %s\n", err.Error())
+	if sess.Options.Tar != nil && *sess.Options.Tar {
+		err = sess.Tar()
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "tar failed: %v\n", err)
+		}
 	}
 
-	if err = agents.NewURLScreenshotter().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLScreenshotter: %s\n", err.Error())
+	if interrupted() {
+		os.Exit(130)
 	}
+}
 
-	if err = agents.NewURLTechnologyFingerprinter().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLTechnologyFingerprinter: %s\n", err.Error())
-	}
+// abortTimeout limits how long agents may take to clean up after a second
+// interrupt before the process exits anyway.
+const abortTimeout = 5 * time.Second
+
+// handleInterrupts cancels the scan on the first SIGINT or SIGTERM so that
+// running tasks can finish and a partial report can be written. On the second
+// one agents close Chrome and delete their temporary files, and the process
+// exits without a report. The returned function reports whether a signal was
+// received.
+func handleInterrupts(cancel context.CancelFunc) func() bool {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	var received int32
+	go func() {
+		<-signals
+		atomic.StoreInt32(&received, 1)
+		sess.Out.Warn("\nInterrupted, waiting for running tasks to finish and writing partial report. Press Ctrl-C again to exit immediately.\n")
+		cancel()
+
+		<-signals
+		sess.Out.Fatal("Interrupted again, exiting\n")
+		aborted := make(chan struct{})
+		go func() {
+			sess.EventBus.Publish(core.SessionAbort)
+			close(aborted)
+		}()
+		select {
+		case <-aborted:
+		case <-time.After(abortTimeout):
+		}
+		os.Exit(1)
+	}()
 
-	if err = agents.NewURLTakeoverDetector().Register(sess); err != nil {
-		sess.Out.Error("Error: Unable to register URLTakeoverDetector: %s\n", err.Error())
+	return func() bool {
+		return atomic.LoadInt32(&received) == 1
 	}
 }
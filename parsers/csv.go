@@ -0,0 +1,130 @@
+package parsers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// CSVParser reads targets from CSV with host, port and scheme columns. Each
+// column is given either by its header name or by its 1-based index. If any
+// column is given by name, the first row is treated as a header.
+type CSVParser struct {
+	HostColumn   string
+	PortColumn   string
+	SchemeColumn string
+}
+
+func NewCSVParser(hostColumn string, portColumn string, schemeColumn string) *CSVParser {
+	return &CSVParser{
+		HostColumn:   hostColumn,
+		PortColumn:   portColumn,
+		SchemeColumn: schemeColumn,
+	}
+}
+
+func (p *CSVParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return targets, err
+	}
+	if len(records) == 0 {
+		return targets, nil
+	}
+
+	var header []string
+	if !isColumnIndex(p.HostColumn) || !isColumnIndex(p.PortColumn) || !isColumnIndex(p.SchemeColumn) {
+		header = records[0]
+		records = records[1:]
+	}
+
+	hostIndex := columnIndex(header, p.HostColumn)
+	if hostIndex == -1 {
+		return targets, fmt.Errorf("host column %s not found", p.HostColumn)
+	}
+	portIndex := columnIndex(header, p.PortColumn)
+	schemeIndex := columnIndex(header, p.SchemeColumn)
+
+	seen := make(map[string]struct{})
+	for _, record := range records {
+		target := p.recordToTarget(record, hostIndex, portIndex, schemeIndex)
+		if target == "" {
+			continue
+		}
+		if _, ok := seen[target]; ok {
+			continue
+		}
+		seen[target] = struct{}{}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+func (p *CSVParser) recordToTarget(record []string, hostIndex int, portIndex int, schemeIndex int) string {
+	host := field(record, hostIndex)
+	if host == "" {
+		return ""
+	}
+	if strings.Contains(host, "://") {
+		return host
+	}
+
+	port, err := strconv.Atoi(field(record, portIndex))
+	if err != nil || port < 1 || port > 65535 {
+		return host
+	}
+
+	switch strings.ToLower(field(record, schemeIndex)) {
+	case "":
+		return net.JoinHostPort(host, strconv.Itoa(port))
+	case "http":
+		return core.HostAndPortToURL(host, port, "http")
+	case "https", "ssl", "tls":
+		return core.HostAndPortToURL(host, port, "https")
+	}
+
+	return ""
+}
+
+func isColumnIndex(column string) bool {
+	if column == "" {
+		return true
+	}
+	_, err := strconv.Atoi(column)
+	return err == nil
+}
+
+// columnIndex returns the 0-based index of a column given by 1-based index or
+// header name, or -1 if there is no such column.
+func columnIndex(header []string, column string) int {
+	if column == "" {
+		return -1
+	}
+	if i, err := strconv.Atoi(column); err == nil {
+		return i - 1
+	}
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return i
+		}
+	}
+	return -1
+}
+
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
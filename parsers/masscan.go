@@ -0,0 +1,78 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"regexp"
+	"strconv"
+)
+
+type masscanPort struct {
+	Port   int    `json:"port"`
+	Proto  string `json:"proto"`
+	Status string `json:"status"`
+}
+
+type masscanHost struct {
+	IP    string        `json:"ip"`
+	Ports []masscanPort `json:"ports"`
+}
+
+// masscan -oJ output often has a trailing comma before the closing bracket.
+var masscanTrailingComma = regexp.MustCompile(`,\s*\]\s*$`)
+
+type MasscanParser struct{}
+
+func NewMasscanParser() *MasscanParser {
+	return &MasscanParser{}
+}
+
+// Parse reads masscan -oJ (JSON array) or -oD (one JSON object per line)
+// output. Every open TCP port is returned as a host:port pair, masscan rarely
+// knows the service, so web and other services are detected when the pairs
+// are processed.
+func (p *MasscanParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return targets, err
+	}
+
+	var hosts []masscanHost
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		data = masscanTrailingComma.ReplaceAll(data, []byte("]"))
+		if err := json.Unmarshal(data, &hosts); err != nil {
+			return targets, err
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		for decoder.More() {
+			var host masscanHost
+			if err := decoder.Decode(&host); err != nil {
+				return targets, err
+			}
+			hosts = append(hosts, host)
+		}
+	}
+
+	seen := make(map[string]struct{})
+	for _, host := range hosts {
+		for _, port := range host.Ports {
+			if host.IP == "" || port.Proto != "tcp" || (port.Status != "" && port.Status != "open") {
+				continue
+			}
+			target := net.JoinHostPort(host.IP, strconv.Itoa(port.Port))
+			if _, ok := seen[target]; ok {
+				continue
+			}
+			seen[target] = struct{}{}
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, nil
+}
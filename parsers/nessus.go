@@ -0,0 +1,101 @@
+package parsers
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+type nessusTag struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type nessusReportItem struct {
+	Port       int    `xml:"port,attr"`
+	Protocol   string `xml:"protocol,attr"`
+	Service    string `xml:"svc_name,attr"`
+	PluginName string `xml:"pluginName,attr"`
+}
+
+type nessusReportHost struct {
+	Name        string             `xml:"name,attr"`
+	Tags        []nessusTag        `xml:"HostProperties>tag"`
+	ReportItems []nessusReportItem `xml:"ReportItem"`
+}
+
+type nessusData struct {
+	Hosts []nessusReportHost `xml:"Report>ReportHost"`
+}
+
+type NessusParser struct{}
+
+func NewNessusParser() *NessusParser {
+	return &NessusParser{}
+}
+
+func (p *NessusParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	var data nessusData
+	if err := xml.NewDecoder(r).Decode(&data); err != nil {
+		return targets, err
+	}
+
+	for _, host := range data.Hosts {
+		targets = append(targets, p.hostToURLs(host)...)
+	}
+
+	return targets, nil
+}
+
+func (p *NessusParser) hostToURLs(host nessusReportHost) []string {
+	hostname := host.Name
+	for _, tag := range host.Tags {
+		if tag.Name == "host-fqdn" && tag.Value != "" {
+			hostname = tag.Value
+			break
+		}
+	}
+
+	// Nessus names both HTTP and HTTPS services "www", so HTTPS is recognized
+	// by TLS related findings on the same port.
+	services := make(map[int]string)
+	tlsPorts := make(map[int]bool)
+	var ports []int
+	for _, item := range host.ReportItems {
+		if item.Port == 0 || item.Protocol != "tcp" {
+			continue
+		}
+		if _, ok := services[item.Port]; !ok {
+			ports = append(ports, item.Port)
+			services[item.Port] = ""
+		}
+		if item.Service != "" && item.Service != "general" {
+			services[item.Port] = strings.TrimSuffix(item.Service, "?")
+		}
+		if strings.Contains(item.PluginName, "SSL") || strings.Contains(item.PluginName, "TLS") {
+			tlsPorts[item.Port] = true
+		}
+	}
+
+	var urls []string
+	for _, port := range ports {
+		var protocol string
+		switch services[port] {
+		case "https", "ssl", "https-alt":
+			protocol = "https"
+		case "www", "http", "http-alt", "http-proxy":
+			protocol = "http"
+			if tlsPorts[port] {
+				protocol = "https"
+			}
+		default:
+			continue
+		}
+		urls = append(urls, core.HostAndPortToURL(hostname, port, protocol))
+	}
+
+	return urls
+}
@@ -1,18 +1,29 @@
 package parsers
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
 
 	"sdg-git.solar.local/golang/aquatone/core"
 
 	"github.com/lair-framework/go-nmap"
 )
 
-type NmapParser struct{}
+type NmapParser struct {
+	// IncludeAllPorts makes the parser return open ports that don't look
+	// like web services as host:port pairs, so that they are probed too.
+	IncludeAllPorts bool
+	notes           map[string][]core.Note
+}
 
 func NewNmapParser() *NmapParser {
-	return &NmapParser{}
+	return &NmapParser{
+		notes: make(map[string][]core.Note),
+	}
 }
 
 func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
@@ -27,7 +38,7 @@ func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
 	}
 
 	for _, host := range scan.Hosts {
-		urls := p.hostToURLs(host)
+		urls := p.hostToTargets(host)
 		for _, url := range urls {
 			targets = append(targets, url)
 		}
@@ -36,48 +47,114 @@ func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
 	return targets, nil
 }
 
-func (p *NmapParser) isHTTPPort(port int) bool {
-	for _, p := range core.XLargePortList {
-		if p == port {
+// Notes returns the service versions and page titles detected by nmap,
+// keyed by host:port.
+func (p *NmapParser) Notes() map[string][]core.Note {
+	return p.notes
+}
+
+func (p *NmapParser) isHTTPService(port nmap.Port) bool {
+	if isHTTPServiceName(port.Service.Name) {
+		return true
+	}
+	if strings.Contains(port.Service.ServiceFp, "HTTP/1.") {
+		return true
+	}
+	if strings.HasSuffix(strings.ToLower(port.Service.Product), "httpd") {
+		return true
+	}
+	for _, script := range port.Scripts {
+		if strings.HasPrefix(script.Id, "http-") {
 			return true
 		}
 	}
 	return false
 }
 
-func (p *NmapParser) hostToURLs(host nmap.Host) []string {
-	var urls []string
+// isHTTPServiceName reports whether name is the nmap name of a web service,
+// such as http, https, http-proxy or ssl/http. Other names containing http,
+// such as ncacn_http (Microsoft RPC over HTTP), aren't web services.
+func isHTTPServiceName(name string) bool {
+	name = strings.TrimPrefix(strings.ToLower(name), "ssl/")
+	return name == "http" || name == "https" || strings.HasPrefix(name, "http-") || strings.HasPrefix(name, "https-")
+}
+
+// isTLSService reports whether nmap found TLS on the port, either with the
+// tunnel attribute or in the service name.
+func isTLSService(service nmap.Service) bool {
+	name := strings.ToLower(service.Name)
+	return service.Tunnel == "ssl" || strings.HasPrefix(name, "ssl/") || name == "https" || strings.HasPrefix(name, "https-")
+}
+
+func (p *NmapParser) portNotes(port nmap.Port) []core.Note {
+	var notes []core.Note
+	service := strings.TrimSpace(strings.Join([]string{port.Service.Product, port.Service.Version}, " "))
+	if service != "" {
+		if port.Service.ExtraInfo != "" {
+			service = fmt.Sprintf("%s (%s)", service, port.Service.ExtraInfo)
+		}
+		notes = append(notes, core.Note{Text: fmt.Sprintf("nmap: %s", service), Type: "info"})
+	}
+	for _, script := range port.Scripts {
+		if script.Id == "http-title" && strings.TrimSpace(script.Output) != "" {
+			notes = append(notes, core.Note{Text: fmt.Sprintf("nmap http-title: %s", strings.TrimSpace(script.Output)), Type: "info"})
+		}
+	}
+	return notes
+}
+
+func (p *NmapParser) hostToTargets(host nmap.Host) []string {
+	var hosts []string
+	if len(host.Hostnames) > 0 {
+		for _, hostname := range host.Hostnames {
+			hosts = append(hosts, hostname.Name)
+		}
+	} else {
+		for _, address := range host.Addresses {
+			if address.AddrType == "mac" {
+				continue
+			}
+			hosts = append(hosts, address.Addr)
+		}
+	}
+
+	var targets []string
 	for _, port := range host.Ports {
-		if port.State.State != "open" {
+		if port.State.State != "open" || (port.Protocol != "" && port.Protocol != "tcp") {
 			continue
 		}
 
+		hostPortOnly := false
 		var protocol string
 		if port.Service.Name == "ssl" {
 			protocol = "https"
+		} else if p.isHTTPService(port) {
+			protocol = "http"
+			if isTLSService(port.Service) {
+				protocol = "https"
+			}
 		} else if port.Service.Tunnel == "ssl" && (port.Service.Name != "smtp" && port.Service.Name != "imap" && port.Service.Name != "pop3") {
 			protocol = "https"
-		} else if port.Service.Name == "http" || port.Service.Name == "http-alt" {
-			protocol = "http"
-		} else {
-			if !p.isHTTPPort(port.PortId) {
+		} else if !isHTTPPort(port.PortId) {
+			if !p.IncludeAllPorts {
 				continue
 			}
+			hostPortOnly = true
 		}
 
-		if len(host.Hostnames) > 0 {
-			for _, hostname := range host.Hostnames {
-				urls = append(urls, core.HostAndPortToURL(hostname.Name, port.PortId, protocol))
+		notes := p.portNotes(port)
+		for _, h := range hosts {
+			hostPort := net.JoinHostPort(strings.ToLower(h), strconv.Itoa(port.PortId))
+			if len(notes) > 0 {
+				p.notes[hostPort] = append(p.notes[hostPort], notes...)
 			}
-		} else {
-			for _, address := range host.Addresses {
-				if address.AddrType == "mac" {
-					continue
-				}
-				urls = append(urls, core.HostAndPortToURL(address.Addr, port.PortId, protocol))
+			if hostPortOnly {
+				targets = append(targets, hostPort)
+			} else {
+				targets = append(targets, core.HostAndPortToURL(h, port.PortId, protocol))
 			}
 		}
 	}
 
-	return urls
+	return targets
 }
@@ -0,0 +1,59 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const nmapReport = `<?xml version="1.0" encoding="UTF-8"?>
+<nmaprun scanner="nmap" args="nmap -sV 10.0.0.5" start="1700000000" version="7.94">
+<host>
+<status state="up" reason="echo-reply"/>
+<address addr="10.0.0.5" addrtype="ipv4"/>
+<ports>
+<port protocol="tcp" portid="80"><state state="open"/><service name="http" product="nginx"/></port>
+<port protocol="tcp" portid="135"><state state="open"/><service name="msrpc"/></port>
+<port protocol="tcp" portid="3128"><state state="open"/><service name="http-proxy" product="Squid http proxy"/></port>
+<port protocol="tcp" portid="4443"><state state="open"/><service name="http" tunnel="ssl"/></port>
+<port protocol="tcp" portid="9443"><state state="open"/><service name="https-alt"/></port>
+<port protocol="tcp" portid="10443"><state state="open"/><service name="ssl/http"/></port>
+<port protocol="tcp" portid="49668"><state state="open"/><service name="ncacn_http" product="Microsoft Windows RPC over HTTP" version="1.0"/></port>
+<port protocol="tcp" portid="8081"><state state="closed"/><service name="http"/></port>
+</ports>
+</host>
+</nmaprun>`
+
+func TestNmapParserServices(t *testing.T) {
+	p := NewNmapParser()
+	targets, err := p.Parse(strings.NewReader(nmapReport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"http://10.0.0.5/",
+		"http://10.0.0.5:3128/",
+		"https://10.0.0.5:4443/",
+		"https://10.0.0.5:9443/",
+		"https://10.0.0.5:10443/",
+	}
+	if !reflect.DeepEqual(targets, want) {
+		t.Errorf("targets = %v, want %v", targets, want)
+	}
+
+	p = NewNmapParser()
+	p.IncludeAllPorts = true
+	targets, err = p.Parse(strings.NewReader(nmapReport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, hostPort := range []string{"10.0.0.5:135", "10.0.0.5:49668"} {
+		found := false
+		for _, target := range targets {
+			found = found || target == hostPort
+		}
+		if !found {
+			t.Errorf("%s isn't probed with IncludeAllPorts, targets: %v", hostPort, targets)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// Parser extracts targets from input. A target is a URL, a host or a
+// host:port pair.
+type Parser interface {
+	Parse(r io.Reader) ([]string, error)
+}
+
+// NoteProvider is implemented by parsers that know more about a target than
+// its address. Notes are keyed by host:port.
+type NoteProvider interface {
+	Notes() map[string][]core.Note
+}
+
+// NewParser returns the parser for an input format.
+func NewParser(format string, options core.Options) (Parser, error) {
+	switch format {
+	case "text":
+		parser := NewRegexParser()
+		parser.MaxRangeSize = *options.MaxRangeSize
+		return parser, nil
+	case "nmap":
+		parser := NewNmapParser()
+		parser.IncludeAllPorts = *options.NmapAllPorts
+		return parser, nil
+	case "masscan":
+		return NewMasscanParser(), nil
+	case "nessus":
+		return NewNessusParser(), nil
+	case "csv":
+		return NewCSVParser(*options.CSVHostColumn, *options.CSVPortColumn, *options.CSVSchemeColumn), nil
+	}
+	return nil, fmt.Errorf("unknown input format %s", format)
+}
+
+// ParseInput parses input in the format selected with -input-format, or
+// detects it when set to auto, and returns the targets found along with notes
+// about them keyed by host:port.
+func ParseInput(r io.Reader, options core.Options) ([]string, map[string][]core.Note, error) {
+	reader := bufio.NewReader(r)
+
+	format := *options.InputFormat
+	if *options.Nmap {
+		format = "nmap"
+	}
+	if format == "auto" {
+		format = DetectFormat(reader, *options.CSVHostColumn)
+	}
+
+	parser, err := NewParser(format, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	targets, err := parser.Parse(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to parse input as %s: %s", format, err)
+	}
+
+	var notes map[string][]core.Note
+	if provider, ok := parser.(NoteProvider); ok {
+		notes = provider.Notes()
+	}
+
+	return targets, notes, nil
+}
+
+// DetectFormat guesses the input format from the beginning of r without
+// consuming it. Input that isn't recognized is treated as text.
+func DetectFormat(r *bufio.Reader, csvHostColumn string) string {
+	head, _ := r.Peek(8192)
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(head)
+
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		if bytes.Contains(trimmed, []byte("<NessusClientData")) {
+			return "nessus"
+		}
+		if bytes.Contains(trimmed, []byte("<nmaprun")) {
+			return "nmap"
+		}
+	case bytes.HasPrefix(trimmed, []byte("[")), bytes.HasPrefix(trimmed, []byte("{")):
+		if bytes.Contains(trimmed, []byte(`"ports"`)) {
+			return "masscan"
+		}
+	default:
+		firstLine := string(trimmed)
+		if i := strings.IndexAny(firstLine, "\r\n"); i != -1 {
+			firstLine = firstLine[:i]
+		}
+		for _, column := range strings.Split(firstLine, ",") {
+			if strings.EqualFold(strings.TrimSpace(column), csvHostColumn) {
+				return "csv"
+			}
+		}
+	}
+
+	return "text"
+}
+
+func isHTTPPort(port int) bool {
+	for _, p := range core.XLargePortList {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
@@ -2,30 +2,230 @@ package parsers
 
 import (
 	"bufio"
+	"fmt"
 	"io"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/mvdan/xurls"
 )
 
-type RegexParser struct{}
+// DefaultMaxRangeSize is the largest number of addresses a single CIDR block
+// or IP range may expand to.
+const DefaultMaxRangeSize = 65536
+
+type RegexParser struct {
+	MaxRangeSize int
+}
 
 func NewRegexParser() *RegexParser {
-	return &RegexParser{}
+	return &RegexParser{
+		MaxRangeSize: DefaultMaxRangeSize,
+	}
 }
 
+// Parse extracts targets from free-form text. CIDR blocks and IP ranges such
+// as 192.168.1.10-50 or 192.168.1.10-192.168.1.50 are expanded into single
+// hosts, IPv6 addresses are kept as hosts and host:port pairs are returned as
+// is, so that only that port is processed.
 func (p *RegexParser) Parse(r io.Reader) ([]string, error) {
 	var targets []string
 	targetsFilter := make(map[string]struct{})
+	add := func(target string) {
+		if _, found := targetsFilter[target]; found {
+			return
+		}
+		targets = append(targets, target)
+		targetsFilter[target] = struct{}{}
+	}
 
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		for _, target := range xurls.Relaxed.FindAllString(scanner.Text(), -1) {
-			if _, found := targetsFilter[target]; found {
+		for _, field := range strings.FieldsFunc(scanner.Text(), isFieldSeparator) {
+			expanded, ok, err := p.parseAddresses(field)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				for _, target := range expanded {
+					add(target)
+				}
 				continue
 			}
-			targets = append(targets, target)
-			targetsFilter[target] = struct{}{}
+
+			for _, target := range xurls.Relaxed.FindAllString(field, -1) {
+				add(target)
+			}
 		}
 	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
 	return targets, nil
 }
+
+func isFieldSeparator(r rune) bool {
+	switch r {
+	case ' ', '\t', ',', ';', '"', '\'':
+		return true
+	}
+	return false
+}
+
+// parseAddresses handles the input forms xurls doesn't understand: CIDR
+// blocks, IP ranges, IPv6 addresses and host:port pairs. ok is false if field
+// is none of these.
+func (p *RegexParser) parseAddresses(field string) ([]string, bool, error) {
+	if strings.Contains(field, "://") {
+		return nil, false, nil
+	}
+
+	if strings.Contains(field, "/") {
+		_, network, err := net.ParseCIDR(field)
+		if err != nil {
+			return nil, false, nil
+		}
+		targets, err := p.expandRange(network.IP, lastAddress(network), field)
+		return targets, true, err
+	}
+
+	if i := strings.Index(field, "-"); i != -1 {
+		first := net.ParseIP(field[:i])
+		if first != nil {
+			last, err := rangeEnd(first, field[i+1:])
+			if err != nil {
+				return nil, true, err
+			}
+			targets, err := p.expandRange(first, last, field)
+			return targets, true, err
+		}
+	}
+
+	if ip := net.ParseIP(strings.Trim(field, "[]")); ip != nil {
+		return []string{ip.String()}, true, nil
+	}
+
+	host, port, err := net.SplitHostPort(field)
+	if err != nil {
+		return nil, false, nil
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil || portNumber < 1 || portNumber > 65535 {
+		return nil, false, nil
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		host = ip.String()
+	} else if !isHostname(host) {
+		return nil, false, nil
+	}
+	return []string{net.JoinHostPort(strings.ToLower(host), port)}, true, nil
+}
+
+// rangeEnd parses the end of a dash range, which is either a full IP address
+// or the last octet of an IPv4 address.
+func rangeEnd(first net.IP, end string) (net.IP, error) {
+	if last := net.ParseIP(end); last != nil {
+		if (first.To4() == nil) != (last.To4() == nil) {
+			return nil, fmt.Errorf("invalid IP range %s-%s: mixed address families", first, end)
+		}
+		return last, nil
+	}
+
+	first4 := first.To4()
+	octet, err := strconv.Atoi(end)
+	if first4 == nil || err != nil || octet < 0 || octet > 255 {
+		return nil, fmt.Errorf("invalid IP range %s-%s", first, end)
+	}
+	last := make(net.IP, len(first4))
+	copy(last, first4)
+	last[3] = byte(octet)
+	return last, nil
+}
+
+func (p *RegexParser) expandRange(first net.IP, last net.IP, field string) ([]string, error) {
+	if v4 := first.To4(); v4 != nil {
+		first = v4
+		last = last.To4()
+	} else {
+		first = first.To16()
+		last = last.To16()
+	}
+
+	if compareIPs(first, last) > 0 {
+		return nil, fmt.Errorf("invalid IP range %s: start is after end", field)
+	}
+
+	var targets []string
+	for ip := first; compareIPs(ip, last) <= 0; ip = nextIP(ip) {
+		if p.MaxRangeSize > 0 && len(targets) >= p.MaxRangeSize {
+			return nil, fmt.Errorf("IP range %s is larger than the limit of %d addresses", field, p.MaxRangeSize)
+		}
+		targets = append(targets, ip.String())
+		if isLastIP(ip) {
+			break
+		}
+	}
+
+	return targets, nil
+}
+
+func lastAddress(network *net.IPNet) net.IP {
+	last := make(net.IP, len(network.IP))
+	for i := range network.IP {
+		last[i] = network.IP[i] | ^network.Mask[i]
+	}
+	return last
+}
+
+func compareIPs(a net.IP, b net.IP) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func isLastIP(ip net.IP) bool {
+	for _, b := range ip {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+func isHostname(s string) bool {
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(s, "."), ".") {
+		if label == "" || len(label) > 63 {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
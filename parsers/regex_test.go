@@ -0,0 +1,111 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegexParserRanges(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "cidr",
+			input: "10.0.0.0/30",
+			want:  []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		},
+		{
+			name:  "single address cidr",
+			input: "10.0.0.5/32",
+			want:  []string{"10.0.0.5"},
+		},
+		{
+			name:  "last octet range",
+			input: "192.168.1.10-12",
+			want:  []string{"192.168.1.10", "192.168.1.11", "192.168.1.12"},
+		},
+		{
+			name:  "full address range",
+			input: "192.168.1.254-192.168.2.1",
+			want:  []string{"192.168.1.254", "192.168.1.255", "192.168.2.0", "192.168.2.1"},
+		},
+		{
+			name:  "range ending at the last address",
+			input: "255.255.255.254-255",
+			want:  []string{"255.255.255.254", "255.255.255.255"},
+		},
+		{
+			name:  "ipv6 cidr",
+			input: "2001:db8::/127",
+			want:  []string{"2001:db8::", "2001:db8::1"},
+		},
+		{
+			name:  "ipv6 range",
+			input: "2001:db8::ff-2001:db8::101",
+			want:  []string{"2001:db8::ff", "2001:db8::100", "2001:db8::101"},
+		},
+		{
+			name:  "ipv6 address",
+			input: "[2001:DB8::1]",
+			want:  []string{"2001:db8::1"},
+		},
+		{
+			name:  "duplicates",
+			input: "10.0.0.1-2, 10.0.0.0/31 10.0.0.2",
+			want:  []string{"10.0.0.1", "10.0.0.2", "10.0.0.0"},
+		},
+		{
+			name:  "host and port pairs",
+			input: "Example.com:8443 10.0.0.1:80 [2001:db8::1]:443",
+			want:  []string{"example.com:8443", "10.0.0.1:80", "[2001:db8::1]:443"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRegexParser().Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %s", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegexParserRangeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"start after end", "10.0.0.20-10"},
+		{"invalid last octet", "10.0.0.1-300"},
+		{"last octet of ipv6", "2001:db8::1-5"},
+		{"mixed families", "10.0.0.1-2001:db8::1"},
+		{"too large", "10.0.0.0/23"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := NewRegexParser()
+			parser.MaxRangeSize = 256
+			if _, err := parser.Parse(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("Parse(%q) returned no error", tt.input)
+			}
+		})
+	}
+}
+
+func TestRegexParserUnlimitedRange(t *testing.T) {
+	parser := NewRegexParser()
+	parser.MaxRangeSize = 0
+	got, err := parser.Parse(strings.NewReader("10.0.0.0/16"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 65536 {
+		t.Errorf("got %d addresses, want 65536", len(got))
+	}
+}
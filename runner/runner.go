@@ -0,0 +1,184 @@
+// Package runner runs Aquatone scans from Go code.
+//
+//	options := core.DefaultOptions()
+//	*options.OutDir = "/tmp/aquatone"
+//	*options.Silent = true
+//	r, err := runner.New(options)
+//	if err != nil {
+//		return err
+//	}
+//	pages, err := r.Run(ctx, []string{"example.com", "https://example.org/"})
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"sdg-git.solar.local/golang/aquatone/agents"
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// ErrAlreadyRun is returned by Run when the Runner was run before.
+var ErrAlreadyRun = errors.New("Runner can only be run once")
+
+// Runner publishes targets to a session with the enabled agents attached
+// and post-processes the results.
+type Runner struct {
+	Session *core.Session
+	lock    sync.Mutex
+	ran     bool
+}
+
+// New creates a session from options and attaches the agents enabled in
+// them.
+func New(options core.Options) (*Runner, error) {
+	sess, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSession(sess)
+}
+
+// NewWithSession attaches the agents enabled in the options of sess to it.
+func NewWithSession(sess *core.Session) (*Runner, error) {
+	enabledAgents, err := agents.EnabledAgents(sess.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, agent := range enabledAgents {
+		if err := agents.AttachAgent(sess, agent); err != nil {
+			return nil, fmt.Errorf("Unable to register %s: %s", agent.ID(), err)
+		}
+	}
+
+	return &Runner{Session: sess}, nil
+}
+
+// Run scans targets, which are hostnames, IP addresses, host:port pairs or
+// URLs, and returns the pages found sorted by URL. It blocks until every
+// target is processed and similar pages are clustered. If ctx is cancelled,
+// agents stop taking on new work and Run returns the pages found so far along
+// with the error of ctx. A Runner can only be run once, later calls return
+// ErrAlreadyRun.
+func (r *Runner) Run(ctx context.Context, targets []string) ([]*core.Page, error) {
+	r.lock.Lock()
+	if r.ran {
+		r.lock.Unlock()
+		return nil, ErrAlreadyRun
+	}
+	r.ran = true
+	r.lock.Unlock()
+
+	sess := r.Session
+	sess.SetContext(ctx)
+
+	sess.EventBus.Publish(core.SessionStart)
+
+	for _, target := range targets {
+		if ctx.Err() != nil {
+			break
+		}
+		if host, port, ok := splitHostPort(target); ok {
+			if sess.InScopeHost(host) && sess.InScopePort(host, port) {
+				sess.EventBus.Publish(core.TCPPort, port, host)
+			}
+		} else if isURL(target) {
+			if sess.HasPage(target) {
+				continue
+			}
+			if hasSupportedScheme(target) {
+				sess.EventBus.Publish(core.URL, target)
+			}
+		} else {
+			sess.EventBus.Publish(core.Host, target)
+		}
+	}
+
+	sess.Drain()
+
+	sess.EventBus.Publish(core.SessionEnd)
+	sess.Drain()
+
+	sess.Out.Important("Calculating page structures...")
+	for _, page := range sess.Pages {
+		body, err := os.Open(sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename())))
+		if err != nil {
+			continue
+		}
+		page.PageStructure, _ = core.GetPageStructure(body)
+		body.Close()
+	}
+	sess.Out.Important(" done\n")
+
+	sess.Out.Important("Clustering similar pages...")
+	sess.ClusterPages(*sess.Options.SimilarityThreshold)
+	sess.Out.Important(" done\n")
+
+	if *sess.Options.Compare != "" {
+		sess.Out.Important("Comparing with previous session...")
+		sess.Compare()
+		sess.Out.Important(" done\n")
+	}
+
+	sess.End()
+
+	return r.Pages(), ctx.Err()
+}
+
+// Pages returns the pages of the session sorted by URL. It is safe to call
+// while the scan is running.
+func (r *Runner) Pages() []*core.Page {
+	r.Session.Lock()
+	pages := make([]*core.Page, 0, len(r.Session.Pages))
+	for _, page := range r.Session.Pages {
+		pages = append(pages, page)
+	}
+	r.Session.Unlock()
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].URL < pages[j].URL
+	})
+	return pages
+}
+
+func isURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return false
+	}
+	return true
+}
+
+// splitHostPort splits bare host:port targets. URLs are not host:port pairs.
+func splitHostPort(s string) (string, int, bool) {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil || host == "" {
+		return "", 0, false
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil || p < 1 || p > 65535 {
+		return "", 0, false
+	}
+	return host, p, true
+}
+
+func hasSupportedScheme(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "http" || u.Scheme == "https" {
+		return true
+	}
+	return false
+}
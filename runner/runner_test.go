@@ -0,0 +1,29 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+func TestRunOnce(t *testing.T) {
+	options := core.DefaultOptions()
+	outDir := t.TempDir()
+	enabled := "url_publisher"
+	silent := true
+	options.OutDir = &outDir
+	options.Agents = &enabled
+	options.Silent = &silent
+	r, err := New(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Run(context.Background(), nil); err != nil {
+		t.Fatalf("first Run returned %v", err)
+	}
+	if pages, err := r.Run(context.Background(), []string{"example.com"}); err != ErrAlreadyRun || pages != nil {
+		t.Errorf("second Run returned %v, %v, want %v", pages, err, ErrAlreadyRun)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"os"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+	"sdg-git.solar.local/golang/aquatone/server"
+)
+
+// serve runs the REST API of the server package: aquatone serve [flags]
+func serve(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	listen := fs.String("listen", "127.0.0.1:8585", "Address to listen on")
+	outDir := fs.String("out", ".", "Directory to write scans to, one subdirectory per scan")
+	maxScans := fs.Int("max-scans", 1, "Number of scans to run at the same time")
+	_ = fs.Parse(args)
+
+	out := core.NewLogger(nil, false, false, false)
+
+	if *maxScans < 1 {
+		out.Fatal("Number of scans to run at the same time must be at least 1\n")
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(*outDir, 0755); err != nil {
+		out.Fatal("Unable to create output directory %s: %s\n", *outDir, err)
+		os.Exit(1)
+	}
+
+	out.Important("%s v%s API listening on http://%s/scans\n", core.Name, core.Version, *listen)
+	if err := http.ListenAndServe(*listen, server.New(*outDir, *maxScans, out)); err != nil {
+		out.Fatal("%s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,552 @@
+// Package server exposes a REST API to queue scans and query their results.
+//
+//	POST   /scans                          submit a scan
+//	GET    /scans                          list scans
+//	GET    /scans/{id}                     scan status and progress
+//	DELETE /scans/{id}                     cancel a queued or running scan
+//	GET    /scans/{id}/pages               pages found so far
+//	GET    /scans/{id}/pages/{uuid}        a single page
+//	GET    /scans/{id}/pages/{uuid}/screenshot, /headers, /body
+//	GET    /scans/{id}/report              rendered HTML report
+//	GET    /scans/{id}/session             aquatone_session.json
+//
+// A scan is submitted as JSON: targets (or input in any format supported by
+// -input-format) and options named like the command line flags:
+//
+//	{"targets": ["example.com"], "options": {"ports": "small", "threads": 4}}
+//
+// Every scan writes to its own directory below the output directory of the
+// server.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"sdg-git.solar.local/golang/aquatone/core"
+	"sdg-git.solar.local/golang/aquatone/parsers"
+	"sdg-git.solar.local/golang/aquatone/runner"
+)
+
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusFinished  = "finished"
+	StatusCancelled = "cancelled"
+	StatusFailed    = "failed"
+)
+
+// Options that can be set through the API. Options that read or write
+// arbitrary files or run executables on the server, such as -out,
+// -chrome-path or -external-agents, are left out, as are -progress and
+// -progress-interval since the progress of a scan is part of its status.
+var allowedOptions = map[string]struct{}{
+	"threads":              {},
+	"checkpoint-interval":  {},
+	"proxy":                {},
+	"header":               {},
+	"auth":                 {},
+	"no-spoof-headers":     {},
+	"path-methods":         {},
+	"soft-404-threshold":   {},
+	"vhosts-from-session":  {},
+	"vhost-threshold":      {},
+	"resolution":           {},
+	"ports":                {},
+	"scan-timeout":         {},
+	"banner-timeout":       {},
+	"host-connections":     {},
+	"http-timeout":         {},
+	"screenshot-timeout":   {},
+	"screenshot-wait":      {},
+	"screenshot-delay":     {},
+	"screenshot-selector":  {},
+	"screenshot-element":   {},
+	"screenshot-format":    {},
+	"screenshot-quality":   {},
+	"full-page":            {},
+	"thumbnail-width":      {},
+	"publish-redirects":    {},
+	"publish-cert-hosts":   {},
+	"rate-limit":           {},
+	"host-rate-limit":      {},
+	"jitter":               {},
+	"max-range-size":       {},
+	"input-format":         {},
+	"csv-host-column":      {},
+	"csv-port-column":      {},
+	"csv-scheme-column":    {},
+	"cluster-mode":         {},
+	"visual-hash":          {},
+	"structure-weight":     {},
+	"visual-weight":        {},
+	"similarity-threshold": {},
+	"agents":               {},
+	"disable-agents":       {},
+	"agent-timeout":        {},
+	"nmap":                 {},
+	"nmap-all-ports":       {},
+	"save-body":            {},
+	"silent":               {},
+	"debug":                {},
+}
+
+type scanRequest struct {
+	Targets []string               `json:"targets"`
+	Input   string                 `json:"input"`
+	Options map[string]interface{} `json:"options"`
+}
+
+type scan struct {
+	sync.Mutex
+	ID         string
+	Status     string
+	Error      string
+	CreatedAt  time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+	dir        string
+	options    core.Options
+	targets    []string
+	notes      map[string][]core.Note
+	runner     *runner.Runner
+	cancel     context.CancelFunc
+}
+
+type scanStatus struct {
+	ID         string                     `json:"id"`
+	Status     string                     `json:"status"`
+	Error      string                     `json:"error,omitempty"`
+	Targets    int                        `json:"targets"`
+	Pages      int                        `json:"pages"`
+	CreatedAt  time.Time                  `json:"createdAt"`
+	StartedAt  *time.Time                 `json:"startedAt,omitempty"`
+	FinishedAt *time.Time                 `json:"finishedAt,omitempty"`
+	Stats      *core.Stats                `json:"stats,omitempty"`
+	Progress   map[string]core.StageStats `json:"progress,omitempty"`
+}
+
+func (sc *scan) status() scanStatus {
+	sc.Lock()
+	defer sc.Unlock()
+	status := scanStatus{
+		ID:        sc.ID,
+		Status:    sc.Status,
+		Error:     sc.Error,
+		Targets:   len(sc.targets),
+		CreatedAt: sc.CreatedAt,
+	}
+	if !sc.StartedAt.IsZero() {
+		startedAt := sc.StartedAt
+		status.StartedAt = &startedAt
+	}
+	if !sc.FinishedAt.IsZero() {
+		finishedAt := sc.FinishedAt
+		status.FinishedAt = &finishedAt
+	}
+	if sc.runner != nil {
+		stats := sc.runner.Session.Stats.Snapshot()
+		if !sc.FinishedAt.IsZero() {
+			stats.FinishedAt = sc.runner.Session.Stats.FinishedAt
+		}
+		status.Stats = &stats
+		status.Pages = len(sc.runner.Pages())
+		status.Progress = make(map[string]core.StageStats)
+		for _, stage := range core.Stages() {
+			if st := sc.runner.Session.Stats.Stage(stage); st.Total() > 0 {
+				status.Progress[stage.String()] = st
+			}
+		}
+	}
+	return status
+}
+
+// Server queues submitted scans and runs up to a fixed number of them at the
+// same time.
+type Server struct {
+	sync.Mutex
+	outDir string
+	scans  map[string]*scan
+	order  []string
+	queue  chan *scan
+	out    *core.Logger
+}
+
+// New starts maxScans workers that run queued scans. Scans write to
+// subdirectories of outDir.
+func New(outDir string, maxScans int, out *core.Logger) *Server {
+	s := &Server{
+		outDir: outDir,
+		scans:  make(map[string]*scan),
+		queue:  make(chan *scan, 1024),
+		out:    out,
+	}
+	for i := 0; i < maxScans; i++ {
+		go s.work()
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "scans" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listScans(w)
+		case http.MethodPost:
+			s.submitScan(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	sc := s.getScan(parts[1])
+	if sc == nil {
+		writeError(w, http.StatusNotFound, "scan not found")
+		return
+	}
+
+	if len(parts) == 2 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, sc.status())
+		case http.MethodDelete:
+			s.cancelScan(sc)
+			writeJSON(w, http.StatusOK, sc.status())
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	switch parts[2] {
+	case "pages":
+		s.servePages(w, r, sc, parts[3:])
+	case "services":
+		s.serveServices(w, sc)
+	case "report":
+		serveFile(w, r, sc, "aquatone_report.html")
+	case "session":
+		serveFile(w, r, sc, "aquatone_session.json")
+	case "screenshots", "headers", "html":
+		// Files referenced by the report with paths relative to it.
+		serveFile(w, r, sc, strings.Join(parts[2:], "/"))
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) submitScan(w http.ResponseWriter, r *http.Request) {
+	var request scanRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request: %s", err))
+		return
+	}
+
+	values := make(map[string][]string)
+	for name, value := range request.Options {
+		if strings.HasPrefix(name, "-") || strings.Contains(name, "=") {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid option name %s", name))
+			return
+		}
+		if _, ok := allowedOptions[name]; !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("option %s can't be set through the API", name))
+			return
+		}
+		// Options that can be given multiple times, such as -header, take
+		// a list of values.
+		list, ok := value.([]interface{})
+		if !ok {
+			list = []interface{}{value}
+		}
+		for _, v := range list {
+			arg, err := optionValue(v)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("option %s: %s", name, err))
+				return
+			}
+			values[name] = append(values[name], arg)
+		}
+	}
+	options, err := core.ParseOptionValues(values)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := request.Input
+	if len(request.Targets) > 0 {
+		input = strings.Join(request.Targets, "\n")
+	}
+	targets, notes, err := parsers.ParseInput(strings.NewReader(input), options)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(targets) == 0 {
+		writeError(w, http.StatusBadRequest, "no targets found in input")
+		return
+	}
+
+	id := uuid.New().String()
+	dir := filepath.Join(s.outDir, id)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	outFile := "aquatone.log"
+	options.OutDir = &dir
+	options.OutFile = &outFile
+
+	sc := &scan{
+		ID:        id,
+		Status:    StatusQueued,
+		CreatedAt: time.Now(),
+		dir:       dir,
+		options:   options,
+		targets:   targets,
+		notes:     notes,
+	}
+
+	s.Lock()
+	select {
+	case s.queue <- sc:
+	default:
+		s.Unlock()
+		writeError(w, http.StatusServiceUnavailable, "too many queued scans")
+		return
+	}
+	s.scans[id] = sc
+	s.order = append(s.order, id)
+	s.Unlock()
+
+	s.out.Info("Queued scan %s with %d targets\n", id, len(targets))
+	writeJSON(w, http.StatusCreated, sc.status())
+}
+
+// optionValue formats a JSON value as the string an option is set from.
+func optionValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", v)
+	}
+}
+
+func (s *Server) listScans(w http.ResponseWriter) {
+	s.Lock()
+	scans := make([]*scan, 0, len(s.order))
+	for _, id := range s.order {
+		scans = append(scans, s.scans[id])
+	}
+	s.Unlock()
+
+	statuses := make([]scanStatus, 0, len(scans))
+	for _, sc := range scans {
+		statuses = append(statuses, sc.status())
+	}
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+func (s *Server) getScan(id string) *scan {
+	s.Lock()
+	defer s.Unlock()
+	return s.scans[id]
+}
+
+func (s *Server) cancelScan(sc *scan) {
+	sc.Lock()
+	defer sc.Unlock()
+	switch sc.Status {
+	case StatusQueued:
+		sc.Status = StatusCancelled
+		sc.FinishedAt = time.Now()
+	case StatusRunning:
+		sc.cancel()
+	}
+}
+
+func (s *Server) servePages(w http.ResponseWriter, r *http.Request, sc *scan, parts []string) {
+	sc.Lock()
+	scanRunner := sc.runner
+	sc.Unlock()
+
+	var pages []*core.Page
+	if scanRunner != nil {
+		pages = scanRunner.Pages()
+	}
+
+	if len(parts) == 0 {
+		if pages == nil {
+			pages = []*core.Page{}
+		}
+		writeJSON(w, http.StatusOK, pages)
+		return
+	}
+
+	var page *core.Page
+	for _, p := range pages {
+		if p.UUID == parts[0] {
+			page = p
+			break
+		}
+	}
+	if page == nil {
+		writeError(w, http.StatusNotFound, "page not found")
+		return
+	}
+
+	if len(parts) == 1 {
+		pageJSON, err := page.ToJSON()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(pageJSON)
+		return
+	}
+
+	page.Lock()
+	files := map[string]string{
+		"screenshot": page.ScreenshotPath,
+		"headers":    page.HeadersPath,
+		"body":       page.BodyPath,
+	}
+	page.Unlock()
+	file, ok := files[parts[1]]
+	if !ok || len(parts) > 2 {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	serveFile(w, r, sc, file)
+}
+
+func (s *Server) serveServices(w http.ResponseWriter, sc *scan) {
+	sc.Lock()
+	scanRunner := sc.runner
+	sc.Unlock()
+
+	services := []*core.Service{}
+	if scanRunner != nil {
+		services = scanRunner.Session.ServiceList()
+	}
+	writeJSON(w, http.StatusOK, services)
+}
+
+func (s *Server) work() {
+	for sc := range s.queue {
+		s.run(sc)
+	}
+}
+
+func (s *Server) run(sc *scan) {
+	sc.Lock()
+	if sc.Status != StatusQueued {
+		sc.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sc.cancel = cancel
+	sc.Status = StatusRunning
+	sc.StartedAt = time.Now()
+	sc.Unlock()
+
+	s.out.Info("Starting scan %s\n", sc.ID)
+	scanRunner, err := runner.New(sc.options)
+	if err != nil {
+		s.finish(sc, StatusFailed, err)
+		return
+	}
+	for hostPort, notes := range sc.notes {
+		for _, note := range notes {
+			scanRunner.Session.AddTargetNote(hostPort, note.Text, note.Type)
+		}
+	}
+	sc.Lock()
+	sc.runner = scanRunner
+	sc.Unlock()
+
+	status := StatusFinished
+	scanRunner.Session.StartCheckpoints("aquatone_session.json", time.Duration(*sc.options.CheckpointInterval)*time.Second)
+	if _, err := scanRunner.Run(ctx, sc.targets); err != nil {
+		status = StatusCancelled
+	}
+	scanRunner.Session.StopCheckpoints()
+
+	if err := scanRunner.Session.WriteReport(); err != nil {
+		s.finish(sc, StatusFailed, err)
+		return
+	}
+	if err := scanRunner.Session.SaveToFile("aquatone_session.json"); err != nil {
+		s.finish(sc, StatusFailed, err)
+		return
+	}
+	scanRunner.Session.Close()
+
+	s.finish(sc, status, nil)
+}
+
+func (s *Server) finish(sc *scan, status string, err error) {
+	sc.Lock()
+	defer sc.Unlock()
+	sc.Status = status
+	sc.FinishedAt = time.Now()
+	if err != nil {
+		sc.Error = err.Error()
+		s.out.Error("Scan %s failed: %s\n", sc.ID, err)
+		return
+	}
+	s.out.Info("Scan %s %s\n", sc.ID, status)
+}
+
+// serveFile serves a file from the directory of a scan. name comes from the
+// request or a page, so it is cleaned to stay inside that directory.
+func serveFile(w http.ResponseWriter, r *http.Request, sc *scan, name string) {
+	if name == "" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	path := filepath.Join(sc.dir, filepath.FromSlash(filepath.Clean("/"+name)))
+	if _, err := os.Stat(path); err != nil {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	http.ServeFile(w, r, path)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"sdg-git.solar.local/golang/aquatone/core"
+)
+
+// newTestServer returns a server without workers, so submitted scans stay
+// queued.
+func newTestServer(t *testing.T) *httptest.Server {
+	s := New(t.TempDir(), 0, core.NewLogger(io.Discard, false, true, true))
+	ts := httptest.NewServer(s)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func doRequest(t *testing.T, method string, url string, body string) (int, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, content
+}
+
+func TestSubmitScanErrors(t *testing.T) {
+	ts := newTestServer(t)
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"invalid json", `{"targets": [`},
+		{"no targets", `{"targets": []}`},
+		{"targets without hosts", `{"input": "nothing to scan here"}`},
+		{"forbidden option", `{"targets": ["example.com"], "options": {"out": "/tmp"}}`},
+		{"option that isn't allowed", `{"targets": ["example.com"], "options": {"external-agents": "/tmp/agents"}}`},
+		{"progress", `{"targets": ["example.com"], "options": {"progress": true}}`},
+		{"option name with dash", `{"targets": ["example.com"], "options": {"-external-agents": "/tmp/agents"}}`},
+		{"option name with value", `{"targets": ["example.com"], "options": {"threads=1 -chrome-path": "/bin/sh"}}`},
+		{"unknown option", `{"targets": ["example.com"], "options": {"no-such-option": true}}`},
+		{"invalid option value", `{"targets": ["example.com"], "options": {"threads": "many"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, http.MethodPost, ts.URL+"/scans", tt.body)
+			if status != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d (%s)", status, http.StatusBadRequest, body)
+			}
+			var errResp map[string]string
+			if err := json.Unmarshal(body, &errResp); err != nil || errResp["error"] == "" {
+				t.Errorf("response %s has no error message", body)
+			}
+		})
+	}
+}
+
+func TestScanLifecycle(t *testing.T) {
+	ts := newTestServer(t)
+
+	status, body := doRequest(t, http.MethodPost, ts.URL+"/scans", `{"targets": ["example.com", "example.org"], "options": {"ports": "small"}}`)
+	if status != http.StatusCreated {
+		t.Fatalf("submit returned %d: %s", status, body)
+	}
+	var submitted scanStatus
+	if err := json.Unmarshal(body, &submitted); err != nil {
+		t.Fatal(err)
+	}
+	if submitted.ID == "" || submitted.Status != StatusQueued || submitted.Targets != 2 {
+		t.Fatalf("unexpected submitted scan %+v", submitted)
+	}
+	scanURL := ts.URL + "/scans/" + submitted.ID
+
+	status, body = doRequest(t, http.MethodGet, ts.URL+"/scans", "")
+	var list []scanStatus
+	if err := json.Unmarshal(body, &list); status != http.StatusOK || err != nil || len(list) != 1 || list[0].ID != submitted.ID {
+		t.Errorf("list returned %d: %s", status, body)
+	}
+
+	if status, body = doRequest(t, http.MethodGet, scanURL+"/pages", ""); status != http.StatusOK || strings.TrimSpace(string(body)) != "[]" {
+		t.Errorf("pages of queued scan returned %d: %s", status, body)
+	}
+
+	status, body = doRequest(t, http.MethodDelete, scanURL, "")
+	var cancelled scanStatus
+	if err := json.Unmarshal(body, &cancelled); status != http.StatusOK || err != nil || cancelled.Status != StatusCancelled || cancelled.FinishedAt == nil {
+		t.Errorf("cancel returned %d: %s", status, body)
+	}
+
+	status, body = doRequest(t, http.MethodGet, scanURL, "")
+	var current scanStatus
+	if err := json.Unmarshal(body, &current); status != http.StatusOK || err != nil || current.Status != StatusCancelled {
+		t.Errorf("status returned %d: %s", status, body)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	ts := newTestServer(t)
+	_, body := doRequest(t, http.MethodPost, ts.URL+"/scans", `{"targets": ["example.com"]}`)
+	var submitted scanStatus
+	if err := json.Unmarshal(body, &submitted); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodGet, "/", http.StatusNotFound},
+		{http.MethodGet, "/targets", http.StatusNotFound},
+		{http.MethodGet, "/scans/unknown", http.StatusNotFound},
+		{http.MethodDelete, "/scans/unknown", http.StatusNotFound},
+		{http.MethodGet, "/scans/" + submitted.ID + "/pages/unknown", http.StatusNotFound},
+		{http.MethodGet, "/scans/" + submitted.ID + "/unknown", http.StatusNotFound},
+		{http.MethodPut, "/scans", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/scans/" + submitted.ID, http.StatusMethodNotAllowed},
+		{http.MethodPost, "/scans/" + submitted.ID + "/pages", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		if status, body := doRequest(t, tt.method, ts.URL+tt.path, ""); status != tt.status {
+			t.Errorf("%s %s returned %d, want %d: %s", tt.method, tt.path, status, tt.status, body)
+		}
+	}
+}